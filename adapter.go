@@ -59,6 +59,7 @@ var (
 		"google.protobuf.Struct":      "google/protobuf/struct.proto",
 		"google.protobuf.ListValue":   "google/protobuf/struct.proto",
 		"google.protobuf.Value":       "google/protobuf/struct.proto",
+		"google.protobuf.FieldMask":   "google/protobuf/field_mask.proto",
 	}
 	wktsPathsList = make([]string, 0, len(wktsPaths))
 )
@@ -164,7 +165,7 @@ func (a *Adapter) parse() error {
 		}
 		fd.Dependency = append(fd.Dependency, depPaths...)
 
-		svcAnnotation, err := extractServiceAnnotation(genType)
+		svcAnnotation, err := ExtractServiceAnnotation(genType)
 		if errors.Is(err, errNoServiceDef) {
 			continue
 		}
@@ -172,15 +173,27 @@ func (a *Adapter) parse() error {
 			return err
 		}
 		if svcAnnotation.Generate {
-			svcResources, err := a.createServiceResources(genType, svcAnnotation.Methods)
+			svcResources, err := a.createServiceResources(genType, svcAnnotation)
 			if err != nil {
 				return err
 			}
 			fd.Service = append(fd.Service, svcResources.svc)
 			fd.MessageType = append(fd.MessageType, svcResources.svcMessages...)
+			fd.EnumType = append(fd.EnumType, svcResources.svcEnums...)
 			fd.Dependency = append(fd.Dependency, "google/protobuf/empty.proto")
 			fd.Dependency = append(fd.Dependency, "google/protobuf/wrappers.proto")
 			fd.Dependency = append(fd.Dependency, "google/protobuf/struct.proto")
+			// The messages of the custom methods may refer to the other well-known types.
+			for _, msg := range svcResources.svcMessages {
+				for _, fld := range msg.Field {
+					if wp, ok := wktsPaths[fld.GetTypeName()]; ok {
+						fd.Dependency = append(fd.Dependency, wp)
+					}
+				}
+			}
+			if svcAnnotation.Methods.Is(MethodGet | MethodUpdate | MethodList | MethodBatchUpdate | MethodStreamList) {
+				fd.Dependency = append(fd.Dependency, "google/protobuf/field_mask.proto")
+			}
 		}
 	}
 
@@ -0,0 +1,188 @@
+package entproto
+
+import (
+	"testing"
+
+	"entgo.io/ent"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// testSchema is an ent schema whose fields and annotations are set by the test.
+type testSchema struct {
+	ent.Schema
+	fields      []ent.Field
+	annotations []schema.Annotation
+}
+
+func (s testSchema) Fields() []ent.Field {
+	return s.fields
+}
+
+func (s testSchema) Annotations() []schema.Annotation {
+	return s.annotations
+}
+
+// loadTestAdapter loads the adapter of a graph holding a single schema named Thing.
+func loadTestAdapter(s testSchema) (*Adapter, error) {
+	b, err := load.MarshalSchema(s)
+	So(err, ShouldBeNil)
+	loaded, err := load.UnmarshalSchema(b)
+	So(err, ShouldBeNil)
+	loaded.Name = "Thing"
+	graph, err := gen.NewGraph(&gen.Config{Package: "example.com/ent"}, loaded)
+	So(err, ShouldBeNil)
+	return LoadAdapter(graph)
+}
+
+func TestReserved(t *testing.T) {
+	Convey("Given a schema reserving field numbers and names", t, func() {
+		thing := func(numbers ...int) testSchema {
+			return testSchema{
+				fields: []ent.Field{
+					field.String("name").Annotations(Field(2), Filter(WithFilterMode(FilterModeEQ))),
+				},
+				annotations: []schema.Annotation{
+					Message(Reserved(numbers...), ReservedNames("nickname")),
+					Service(Methods(MethodUpdate | MethodList)),
+				},
+			}
+		}
+
+		Convey("Then the messages mirroring its fields reserve them too", func() {
+			a, err := loadTestAdapter(thing(3, 4, 536870911))
+			So(err, ShouldBeNil)
+			fd, err := a.GetFileDescriptor("Thing")
+			So(err, ShouldBeNil)
+			for _, name := range []string{"Thing", "UpdateThingRequest", "ListThingFilter"} {
+				msg := fd.Messages().ByName(protoreflect.Name(name))
+				So(msg, ShouldNotBeNil)
+				So(msg.ReservedRanges().Has(4), ShouldBeTrue)
+				So(msg.ReservedRanges().Has(536870911), ShouldBeTrue)
+				So(msg.ReservedNames().Has("nickname"), ShouldBeTrue)
+			}
+		})
+		Convey("Then numbers above the maximum field number are rejected", func() {
+			a, err := loadTestAdapter(thing(536870912))
+			So(err, ShouldBeNil)
+			_, err = a.GetMessageDescriptor("Thing")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "invalid field number 536870912")
+		})
+		Convey("Then numbers reserved by protobuf are rejected", func() {
+			for _, num := range []int{19000, 19999} {
+				a, err := loadTestAdapter(thing(num))
+				So(err, ShouldBeNil)
+				_, err = a.GetMessageDescriptor("Thing")
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "reserved by protobuf")
+			}
+		})
+		Convey("Then zero and negative numbers are rejected", func() {
+			for _, num := range []int{0, -1} {
+				a, err := loadTestAdapter(thing(num))
+				So(err, ShouldBeNil)
+				_, err = a.GetMessageDescriptor("Thing")
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "invalid field number")
+			}
+		})
+	})
+}
+
+// loadFilterAdapter loads the adapter of a schema with a List method filtering on the given field.
+func loadFilterAdapter(f ent.Field) (*Adapter, error) {
+	return loadTestAdapter(testSchema{
+		fields:      []ent.Field{f},
+		annotations: []schema.Annotation{Message(), Service(Methods(MethodList))},
+	})
+}
+
+// filterField returns the field of the ListThingFilter message with the given name.
+func filterField(a *Adapter, name string) protoreflect.FieldDescriptor {
+	fd, err := a.GetFileDescriptor("Thing")
+	So(err, ShouldBeNil)
+	return fd.Messages().ByName("ListThingFilter").Fields().ByName(protoreflect.Name(name))
+}
+
+func TestFilterModeIsNil(t *testing.T) {
+	Convey("Given an is-nil filter", t, func() {
+		isNil := Filter(WithFilterMode(FilterModeIsNil))
+
+		Convey("Then it is generated for optional and nillable fields", func() {
+			a, err := loadFilterAdapter(field.String("note").Optional().Annotations(Field(2), isNil))
+			So(err, ShouldBeNil)
+			So(string(filterField(a, "note_is_nil").Message().FullName()), ShouldEqual, "google.protobuf.BoolValue")
+
+			a, err = loadFilterAdapter(field.String("note").Nillable().Annotations(Field(2), isNil))
+			So(err, ShouldBeNil)
+			So(filterField(a, "note_is_nil"), ShouldNotBeNil)
+		})
+		Convey("Then required fields are rejected", func() {
+			_, err := loadFilterAdapter(field.String("note").Annotations(Field(2), isNil))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "is-nil filter mode is only supported for optional or nillable fields")
+		})
+	})
+}
+
+func TestFilterModeRange(t *testing.T) {
+	Convey("Given a range filter", t, func() {
+		between := Filter(
+			WithFilterMode(FilterModeRange),
+			WithFilterNumber(FilterModeGTE, 20),
+			WithFilterNumber(FilterModeLTE, 21),
+		)
+
+		Convey("Then it is generated for numeric and time fields", func() {
+			a, err := loadFilterAdapter(field.Int("age").Annotations(Field(2), between))
+			So(err, ShouldBeNil)
+			So(filterField(a, "age_gte").Number(), ShouldEqual, 20)
+			So(filterField(a, "age_lte").Number(), ShouldEqual, 21)
+			So(filterField(a, "age_gt"), ShouldBeNil)
+
+			a, err = loadFilterAdapter(field.Time("seen_at").Annotations(Field(2), between))
+			So(err, ShouldBeNil)
+			So(string(filterField(a, "seen_at_gte").Message().FullName()), ShouldEqual, "google.protobuf.Timestamp")
+		})
+		Convey("Then fields of other types are rejected", func() {
+			_, err := loadFilterAdapter(field.String("name").Annotations(Field(2), between))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "only supported for numeric and time fields")
+
+			_, err = loadFilterAdapter(field.Bool("active").Annotations(Field(2), Filter(WithFilterMode(FilterModeGT))))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "GT filter mode is only supported for numeric and time fields")
+		})
+	})
+}
+
+func TestFilterModeStrings(t *testing.T) {
+	Convey("Given string filters", t, func() {
+		text := Filter(
+			WithFilterMode(FilterModeHasPrefix|FilterModeContainsFold),
+			WithFilterNumber(FilterModeHasPrefix, 20),
+			WithFilterNumber(FilterModeContainsFold, 21),
+		)
+
+		Convey("Then they are generated for string fields", func() {
+			a, err := loadFilterAdapter(field.String("name").Annotations(Field(2), text))
+			So(err, ShouldBeNil)
+			So(filterField(a, "name_has_prefix").Number(), ShouldEqual, 20)
+			So(filterField(a, "name_contains_fold").Number(), ShouldEqual, 21)
+		})
+		Convey("Then fields of other types are rejected", func() {
+			_, err := loadFilterAdapter(field.Int("age").Annotations(Field(2), text))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "HasPrefix filter mode is only supported for string fields")
+
+			_, err = loadFilterAdapter(field.Bytes("avatar").Annotations(Field(2), Filter(WithFilterMode(FilterModeContains))))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "Contains filter mode is only supported for string fields")
+		})
+	})
+}
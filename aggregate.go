@@ -0,0 +1,259 @@
+package entproto
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/yoshino-s/entproto/annotations"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// MaxAggregateMetrics is the maximum number of metrics computed by a single Aggregate call. Requests exceeding it
+	// return an error.
+	MaxAggregateMetrics = 10
+	// MaxAggregateGroups is the maximum number of groups returned by a single Aggregate call. Requests grouping the
+	// entities into more groups return an error.
+	MaxAggregateGroups = 1000
+)
+
+// AggregateFunctions are the functions computed by the Aggregate method, in the order of the values of its
+// <T>AggregateFunction enum. They are named after the ent aggregate functions.
+var AggregateFunctions = []string{"count", "sum", "min", "max", "mean"}
+
+// genCountProtos returns the Count method counting the entities matching a List<T>Filter.
+func (a *Adapter) genCountProtos(genType *gen.Type) (methodResources, error) {
+	protoMessageFieldType := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	int64FieldType := descriptorpb.FieldDescriptorProto_TYPE_INT64
+	noSideEffectIdempotencyLevel := descriptorpb.MethodOptions_NO_SIDE_EFFECTS
+
+	input := &descriptorpb.DescriptorProto{
+		Name: strptr(fmt.Sprintf("Count%sRequest", genType.Name)),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     strptr("filter"),
+				Number:   int32ptr(1),
+				Type:     &protoMessageFieldType,
+				TypeName: strptr(fmt.Sprintf("List%sFilter", genType.Name)),
+			},
+		},
+	}
+	if softDeleteField, err := ExtractSoftDeleteField(genType); err != nil {
+		return methodResources{}, err
+	} else if softDeleteField != nil {
+		input.Field = append(input.Field, showDeletedField(countShowDeletedFieldNumber))
+	}
+	output := &descriptorpb.DescriptorProto{
+		Name: strptr(fmt.Sprintf("Count%sResponse", genType.Name)),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:   strptr("count"),
+				Number: int32ptr(1),
+				Type:   &int64FieldType,
+			},
+		},
+	}
+	return methodResources{
+		methodDescriptor: &descriptorpb.MethodDescriptorProto{
+			Name:       strptr("Count"),
+			InputType:  input.Name,
+			OutputType: output.Name,
+			Options: &descriptorpb.MethodOptions{
+				IdempotencyLevel: &noSideEffectIdempotencyLevel,
+			},
+		},
+		messages: []*descriptorpb.DescriptorProto{input, output},
+	}, nil
+}
+
+// genAggregateProtos returns the Aggregate method computing metrics over the entities matching a List<T>Filter,
+// grouped by the groupable fields of the schema.
+func (a *Adapter) genAggregateProtos(genType *gen.Type) (methodResources, error) {
+	protoMessageFieldType := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	enumFieldType := descriptorpb.FieldDescriptorProto_TYPE_ENUM
+	repeatedFieldLabel := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	noSideEffectIdempotencyLevel := descriptorpb.MethodOptions_NO_SIDE_EFFECTS
+
+	groupEnum, err := extractGroupFieldEnum(genType)
+	if err != nil {
+		return methodResources{}, err
+	}
+	aggregateFieldEnum, err := extractAggregateFieldEnum(genType)
+	if err != nil {
+		return methodResources{}, err
+	}
+	functionEnum := extractAggregateFunctionEnum(genType)
+	metricMessage := &descriptorpb.DescriptorProto{
+		Name: strptr(fmt.Sprintf("Aggregate%sMetric", genType.Name)),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     strptr("function"),
+				Number:   int32ptr(1),
+				Type:     &enumFieldType,
+				TypeName: functionEnum.Name,
+			},
+			// The field isn't set by count metrics.
+			{
+				Name:     strptr("field"),
+				Number:   int32ptr(2),
+				Type:     &enumFieldType,
+				TypeName: aggregateFieldEnum.Name,
+			},
+		},
+	}
+	input := &descriptorpb.DescriptorProto{
+		Name: strptr(fmt.Sprintf("Aggregate%sRequest", genType.Name)),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     strptr("filter"),
+				Number:   int32ptr(1),
+				Type:     &protoMessageFieldType,
+				TypeName: strptr(fmt.Sprintf("List%sFilter", genType.Name)),
+			},
+			{
+				Name:     strptr("group_by"),
+				Number:   int32ptr(2),
+				Label:    &repeatedFieldLabel,
+				Type:     &enumFieldType,
+				TypeName: groupEnum.Name,
+			},
+			{
+				Name:     strptr("metrics"),
+				Number:   int32ptr(3),
+				Label:    &repeatedFieldLabel,
+				Type:     &protoMessageFieldType,
+				TypeName: metricMessage.Name,
+			},
+		},
+	}
+	if softDeleteField, err := ExtractSoftDeleteField(genType); err != nil {
+		return methodResources{}, err
+	} else if softDeleteField != nil {
+		input.Field = append(input.Field, showDeletedField(aggregateShowDeletedFieldNumber))
+	}
+	// The key of a group only sets the fields the request groups by. Its values follow the order of the metrics, and
+	// are null for metrics without value, such as the sum of an empty set.
+	groupMessage := &descriptorpb.DescriptorProto{
+		Name: strptr(fmt.Sprintf("Aggregate%sGroup", genType.Name)),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     strptr("key"),
+				Number:   int32ptr(1),
+				Type:     &protoMessageFieldType,
+				TypeName: strptr(genType.Name),
+			},
+			{
+				Name:     strptr("values"),
+				Number:   int32ptr(2),
+				Label:    &repeatedFieldLabel,
+				Type:     &protoMessageFieldType,
+				TypeName: strptr("google.protobuf.Value"),
+			},
+		},
+	}
+	output := &descriptorpb.DescriptorProto{
+		Name: strptr(fmt.Sprintf("Aggregate%sResponse", genType.Name)),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     strptr("groups"),
+				Number:   int32ptr(1),
+				Label:    &repeatedFieldLabel,
+				Type:     &protoMessageFieldType,
+				TypeName: groupMessage.Name,
+			},
+		},
+	}
+	return methodResources{
+		methodDescriptor: &descriptorpb.MethodDescriptorProto{
+			Name:       strptr("Aggregate"),
+			InputType:  input.Name,
+			OutputType: output.Name,
+			Options: &descriptorpb.MethodOptions{
+				IdempotencyLevel: &noSideEffectIdempotencyLevel,
+			},
+		},
+		messages: []*descriptorpb.DescriptorProto{metricMessage, input, groupMessage, output},
+		enums:    []*descriptorpb.EnumDescriptorProto{groupEnum, aggregateFieldEnum, functionEnum},
+	}, nil
+}
+
+// extractGroupFieldEnum returns the <T>GroupField enum listing the groupable fields of the schema.
+func extractGroupFieldEnum(genType *gen.Type) (*descriptorpb.EnumDescriptorProto, error) {
+	var fields []*gen.Field
+	for _, fld := range genType.Fields {
+		if !annotations.IsGroupable(fld) {
+			continue
+		}
+		switch fld.Type.Type {
+		case field.TypeJSON, field.TypeBytes, field.TypeOther:
+			return nil, fmt.Errorf("entproto: %s field %q of schema %q cannot be groupable",
+				fld.Type.Type, fld.Name, genType.Name)
+		}
+		// The grouped columns are scanned into the entity by name.
+		if fld.StorageKey() != fld.Name {
+			return nil, fmt.Errorf("entproto: groupable field %q of schema %q must not set a storage key",
+				fld.Name, genType.Name)
+		}
+		fields = append(fields, fld)
+	}
+	return fieldEnum(genType.Name+"GroupField", fields)
+}
+
+// extractAggregateFieldEnum returns the <T>AggregateField enum listing the aggregatable fields of the schema.
+func extractAggregateFieldEnum(genType *gen.Type) (*descriptorpb.EnumDescriptorProto, error) {
+	var fields []*gen.Field
+	for _, fld := range genType.Fields {
+		if !annotations.IsAggregatable(fld) {
+			continue
+		}
+		if !fld.Type.Numeric() {
+			return nil, fmt.Errorf("entproto: %s field %q of schema %q cannot be aggregatable",
+				fld.Type.Type, fld.Name, genType.Name)
+		}
+		fields = append(fields, fld)
+	}
+	return fieldEnum(genType.Name+"AggregateField", fields)
+}
+
+// extractAggregateFunctionEnum returns the <T>AggregateFunction enum of the Aggregate method.
+func extractAggregateFunctionEnum(genType *gen.Type) *descriptorpb.EnumDescriptorProto {
+	name := genType.Name + "AggregateFunction"
+	prefix := strings.ToUpper(snake(name))
+	enum := &descriptorpb.EnumDescriptorProto{
+		Name: strptr(name),
+		Value: []*descriptorpb.EnumValueDescriptorProto{
+			{Name: strptr(prefix + "_UNSPECIFIED"), Number: int32ptr(0)},
+		},
+	}
+	for i, fn := range AggregateFunctions {
+		enum.Value = append(enum.Value, &descriptorpb.EnumValueDescriptorProto{
+			Name:   strptr(prefix + "_" + strings.ToUpper(fn)),
+			Number: int32ptr(int32(i + 1)),
+		})
+	}
+	return enum
+}
+
+// fieldEnum returns an enum listing fields of a schema, numbered after their entproto.Field annotation.
+func fieldEnum(name string, fields []*gen.Field) (*descriptorpb.EnumDescriptorProto, error) {
+	prefix := strings.ToUpper(snake(name))
+	enum := &descriptorpb.EnumDescriptorProto{
+		Name: strptr(name),
+		Value: []*descriptorpb.EnumValueDescriptorProto{
+			{Name: strptr(prefix + "_UNSPECIFIED"), Number: int32ptr(0)},
+		},
+	}
+	for _, fld := range fields {
+		fieldAnnotation, err := annotations.ExtractFieldAnnotation(fld)
+		if err != nil {
+			return nil, err
+		}
+		enum.Value = append(enum.Value, &descriptorpb.EnumValueDescriptorProto{
+			Name:   strptr(prefix + "_" + strings.ToUpper(snake(fld.Name))),
+			Number: int32ptr(int32(fieldAnnotation.Number)),
+		})
+	}
+	return enum, nil
+}
@@ -5,11 +5,13 @@ import (
 )
 
 type (
-	MessageOption = annotations.MessageOption
-	EnumOption    = annotations.EnumOption
-	FilterOption  = annotations.FilterOption
-	FilterMode    = annotations.FilterMode
-	FieldOption   = annotations.FieldOption
+	MessageOption  = annotations.MessageOption
+	EnumOption     = annotations.EnumOption
+	FilterOption   = annotations.FilterOption
+	FilterMode     = annotations.FilterMode
+	FieldOption    = annotations.FieldOption
+	OrderOption    = annotations.OrderOption
+	OrderDirection = annotations.OrderDirection
 )
 
 var (
@@ -17,6 +19,8 @@ var (
 	Message           = annotations.Message
 	SkipGen           = annotations.SkipGen
 	PackageName       = annotations.PackageName
+	Reserved          = annotations.Reserved
+	ReservedNames     = annotations.ReservedNames
 
 	EnumAnnotation            = annotations.EnumAnnotation
 	ErrEnumFieldsNotAnnotated = annotations.ErrEnumFieldsNotAnnotated
@@ -24,19 +28,49 @@ var (
 	OmitFieldPrefix           = annotations.OmitFieldPrefix
 	NormalizeEnumIdentifier   = annotations.NormalizeEnumIdentifier
 
-	FilterAnnotation   = annotations.FilterAnnotation
-	Filter             = annotations.Filter
-	FilterContains     = annotations.FilterContains
-	WithFilterMode     = annotations.WithFilterMode
-	FilterModeEQ       = annotations.FilterModeEQ
-	FilterModeContains = annotations.FilterModeContains
-	FilterModeIn       = annotations.FilterModeIn
+	FilterAnnotation       = annotations.FilterAnnotation
+	Filter                 = annotations.Filter
+	FilterContains         = annotations.FilterContains
+	WithFilterMode         = annotations.WithFilterMode
+	WithFilterNumber       = annotations.WithFilterNumber
+	FilterModeEQ           = annotations.FilterModeEQ
+	FilterModeContains     = annotations.FilterModeContains
+	FilterModeIn           = annotations.FilterModeIn
+	FilterModeGT           = annotations.FilterModeGT
+	FilterModeGTE          = annotations.FilterModeGTE
+	FilterModeLT           = annotations.FilterModeLT
+	FilterModeLTE          = annotations.FilterModeLTE
+	FilterModeRange        = annotations.FilterModeRange
+	FilterModeNEQ          = annotations.FilterModeNEQ
+	FilterModeNotIn        = annotations.FilterModeNotIn
+	FilterModeIsNil        = annotations.FilterModeIsNil
+	FilterModeHasPrefix    = annotations.FilterModeHasPrefix
+	FilterModeHasSuffix    = annotations.FilterModeHasSuffix
+	FilterModeEqualFold    = annotations.FilterModeEqualFold
+	FilterModeContainsFold = annotations.FilterModeContainsFold
+	FilterModeHasEdge      = annotations.FilterModeHasEdge
 
-	FieldAnnotation = annotations.FieldAnnotation
-	Field           = annotations.Field
-	Type            = annotations.Type
-	TypeName        = annotations.TypeName
+	FieldAnnotation   = annotations.FieldAnnotation
+	Field             = annotations.Field
+	Type              = annotations.Type
+	TypeName          = annotations.TypeName
+	EdgeUpdateNumbers = annotations.EdgeUpdateNumbers
+	EdgeClearNumber   = annotations.EdgeClearNumber
 
 	SkipAnnotation = annotations.SkipAnnotation
 	Skip           = annotations.Skip
+
+	SortableAnnotation = annotations.SortableAnnotation
+	Sortable           = annotations.Sortable
+	OrderAnnotation    = annotations.OrderAnnotation
+	ListOrder          = annotations.ListOrder
+	DefaultOrder       = annotations.DefaultOrder
+	TieBreaker         = annotations.TieBreaker
+	OrderAsc           = annotations.OrderAsc
+	OrderDesc          = annotations.OrderDesc
+
+	GroupableAnnotation    = annotations.GroupableAnnotation
+	Groupable              = annotations.Groupable
+	AggregatableAnnotation = annotations.AggregatableAnnotation
+	Aggregatable           = annotations.Aggregatable
 )
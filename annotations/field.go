@@ -25,6 +25,10 @@ type pbfield struct {
 	Number   int
 	Type     descriptorpb.FieldDescriptorProto_Type
 	TypeName string
+	// AddNumber, RemoveNumber and ClearNumber number the fields of Update<T>Request operating on an edge.
+	AddNumber    int
+	RemoveNumber int
+	ClearNumber  int
 }
 
 func (f pbfield) Name() string {
@@ -53,6 +57,40 @@ func TypeName(n string) FieldOption {
 	}
 }
 
+// EdgeUpdateNumbers numbers the add_<edge>_ids and remove_<edge>_ids fields generated in Update<T>Request for a
+// non-unique edge. Without it, the request can only replace the neighbors of the edge.
+// Example:
+//
+//	edge.To("users", User.Type).
+//		Annotations(
+//			entproto.Field(3,
+//				entproto.EdgeUpdateNumbers(101, 102),
+//			),
+//		)
+func EdgeUpdateNumbers(add, remove int) FieldOption {
+	return func(p *pbfield) {
+		p.AddNumber = add
+		p.RemoveNumber = remove
+	}
+}
+
+// EdgeClearNumber numbers the clear_<edge> field generated in Update<T>Request for a unique, optional edge.
+func EdgeClearNumber(num int) FieldOption {
+	return func(p *pbfield) {
+		p.ClearNumber = num
+	}
+}
+
+// FieldNumber returns the number set by the entproto.Field annotation found in annots, if any.
+func FieldNumber(annots []schema.Annotation) (int, bool) {
+	for _, annot := range annots {
+		if f, ok := annot.(pbfield); ok {
+			return f.Number, true
+		}
+	}
+	return 0, false
+}
+
 func ExtractFieldAnnotation(fld *gen.Field) (*pbfield, error) {
 	annot, ok := fld.Annotations[FieldAnnotation]
 	if !ok {
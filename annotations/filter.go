@@ -2,6 +2,7 @@ package annotations
 
 import (
 	"fmt"
+	"math/bits"
 
 	"entgo.io/ent/entc/gen"
 	"github.com/go-viper/mapstructure/v2"
@@ -33,16 +34,112 @@ func WithFilterMode(mode FilterMode) FilterOption {
 	}
 }
 
+// WithFilterNumber sets the field number of the List<T>Filter field generated for mode. Filter field numbers are part
+// of the wire format, so they must stay stable once published:
+//
+//	field.String("name").
+//		Annotations(
+//			entproto.Field(2),
+//			entproto.Filter(
+//				entproto.WithFilterMode(entproto.FilterModeEQ|entproto.FilterModeIn),
+//				entproto.WithFilterNumber(entproto.FilterModeIn, 20),
+//			),
+//		)
+func WithFilterNumber(mode FilterMode, num int) FilterOption {
+	return func(f *filter) {
+		f.Numbers = append(f.Numbers, filterNumber{Mode: mode, Number: num})
+	}
+}
+
 type FilterMode int
 
 const (
 	FilterModeEQ FilterMode = 1 << iota
 	FilterModeContains
 	FilterModeIn
+	FilterModeGT
+	FilterModeGTE
+	FilterModeLT
+	FilterModeLTE
+	FilterModeNEQ
+	FilterModeNotIn
+	// FilterModeIsNil generates a BoolValue filter, selecting the entities where the field is nil when true, and
+	// where it is set when false. It is only supported on Optional or Nillable fields.
+	FilterModeIsNil
+	FilterModeHasPrefix
+	FilterModeHasSuffix
+	// FilterModeEqualFold matches strings equal under Unicode case-folding.
+	FilterModeEqualFold
+	// FilterModeContainsFold matches strings containing the value under Unicode case-folding.
+	FilterModeContainsFold
+	// FilterModeHasEdge generates a has_<edge> BoolValue filter on an edge, selecting the entities with at least one
+	// neighbor when true, and without any when false.
+	FilterModeHasEdge
+
+	// FilterModeRange filters values within an inclusive range, it is the same as FilterModeGTE|FilterModeLTE.
+	FilterModeRange = FilterModeGTE | FilterModeLTE
 )
 
+func (m FilterMode) String() string {
+	switch m {
+	case FilterModeEQ:
+		return "EQ"
+	case FilterModeContains:
+		return "Contains"
+	case FilterModeIn:
+		return "In"
+	case FilterModeGT:
+		return "GT"
+	case FilterModeGTE:
+		return "GTE"
+	case FilterModeLT:
+		return "LT"
+	case FilterModeLTE:
+		return "LTE"
+	case FilterModeNEQ:
+		return "NEQ"
+	case FilterModeNotIn:
+		return "NotIn"
+	case FilterModeIsNil:
+		return "IsNil"
+	case FilterModeHasPrefix:
+		return "HasPrefix"
+	case FilterModeHasSuffix:
+		return "HasSuffix"
+	case FilterModeEqualFold:
+		return "EqualFold"
+	case FilterModeContainsFold:
+		return "ContainsFold"
+	case FilterModeHasEdge:
+		return "HasEdge"
+	default:
+		return fmt.Sprintf("FilterMode(%d)", int(m))
+	}
+}
+
 type filter struct {
-	Mode FilterMode
+	Mode    FilterMode
+	Numbers []filterNumber
+}
+
+type filterNumber struct {
+	Mode   FilterMode
+	Number int
+}
+
+// Number returns the field number of the List<T>Filter field generated for mode. Numbers set with WithFilterNumber
+// take precedence. Otherwise the EQ mode, or the only mode of the annotation, reuses the entproto.Field number of the
+// annotated field. Any other mode must be numbered explicitly.
+func (f *filter) Number(mode FilterMode, fieldNumber int) (int, error) {
+	for _, n := range f.Numbers {
+		if n.Mode == mode {
+			return n.Number, nil
+		}
+	}
+	if mode == FilterModeEQ || bits.OnesCount(uint(f.Mode)) == 1 {
+		return fieldNumber, nil
+	}
+	return 0, fmt.Errorf("entproto: filter mode %s has no field number, set one with entproto.WithFilterNumber", mode)
 }
 
 func (f *filter) Name() string {
@@ -64,3 +161,20 @@ func ExtractFilterAnnotation(sch *gen.Field) (*filter, error) {
 
 	return &out, nil
 }
+
+// ExtractEdgeFilterAnnotation returns the entproto.Filter annotation of an edge, or nil if the edge is not annotated.
+func ExtractEdgeFilterAnnotation(edge *gen.Edge) (*filter, error) {
+	annot, ok := edge.Annotations[FilterAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	var out filter
+	err := mapstructure.Decode(annot, &out)
+	if err != nil {
+		return nil, fmt.Errorf("entproto: unable to decode entproto.Filter annotation for edge %q: %w",
+			edge.Name, err)
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,47 @@
+package annotations
+
+import (
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema"
+)
+
+const (
+	GroupableAnnotation    = "ProtoGroupable"
+	AggregatableAnnotation = "ProtoAggregatable"
+)
+
+type groupable struct{}
+
+// Groupable annotates a field to allow grouping the results of the generated Aggregate method by it. Only groupable
+// fields are values of the generated <T>GroupField enum.
+func Groupable() schema.Annotation {
+	return groupable{}
+}
+
+func (groupable) Name() string {
+	return GroupableAnnotation
+}
+
+// IsGroupable reports whether the field is annotated with entproto.Groupable.
+func IsGroupable(fld *gen.Field) bool {
+	_, ok := fld.Annotations[GroupableAnnotation]
+	return ok
+}
+
+type aggregatable struct{}
+
+// Aggregatable annotates a numeric field to allow computing the sum, min, max and mean of its values with the
+// generated Aggregate method. Only aggregatable fields are values of the generated <T>AggregateField enum.
+func Aggregatable() schema.Annotation {
+	return aggregatable{}
+}
+
+func (aggregatable) Name() string {
+	return AggregatableAnnotation
+}
+
+// IsAggregatable reports whether the field is annotated with entproto.Aggregatable.
+func IsAggregatable(fld *gen.Field) bool {
+	_, ok := fld.Annotations[AggregatableAnnotation]
+	return ok
+}
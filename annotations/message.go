@@ -55,9 +55,26 @@ func PackageName(pkg string) MessageOption {
 	}
 }
 
+// Reserved marks field numbers as reserved on the generated message, so they can't be reused by fields or edges
+// added later. Use it when removing a field or an edge from the schema.
+func Reserved(numbers ...int) MessageOption {
+	return func(msg *message) {
+		msg.Reserved = append(msg.Reserved, numbers...)
+	}
+}
+
+// ReservedNames marks field names as reserved on the generated message.
+func ReservedNames(names ...string) MessageOption {
+	return func(msg *message) {
+		msg.ReservedNames = append(msg.ReservedNames, names...)
+	}
+}
+
 type message struct {
-	Generate bool
-	Package  string
+	Generate      bool
+	Package       string
+	Reserved      []int
+	ReservedNames []string
 }
 
 func (m message) Name() string {
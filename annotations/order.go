@@ -0,0 +1,141 @@
+package annotations
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema"
+	"github.com/go-viper/mapstructure/v2"
+)
+
+const (
+	SortableAnnotation = "ProtoSortable"
+	OrderAnnotation    = "ProtoOrder"
+)
+
+type sortable struct{}
+
+// Sortable annotates a field to allow ordering the results of the generated List method by it. Only sortable
+// fields, and the ID, are values of the generated <T>OrderField enum.
+func Sortable() schema.Annotation {
+	return sortable{}
+}
+
+func (sortable) Name() string {
+	return SortableAnnotation
+}
+
+// IsSortable reports whether the field is annotated with entproto.Sortable.
+func IsSortable(fld *gen.Field) bool {
+	_, ok := fld.Annotations[SortableAnnotation]
+	return ok
+}
+
+// OrderDirection is the direction of an order term.
+type OrderDirection int
+
+const (
+	OrderAsc OrderDirection = iota
+	OrderDesc
+)
+
+// OrderOption configures the entproto.ListOrder annotation.
+type OrderOption func(o *order)
+
+// ListOrder annotates an ent.Schema to configure how the results of the generated List method are ordered when the
+// request doesn't specify an order:
+//
+//	entproto.ListOrder(
+//		entproto.DefaultOrder("created_at", entproto.OrderDesc),
+//		entproto.TieBreaker("id"),
+//	)
+func ListOrder(opts ...OrderOption) schema.Annotation {
+	o := order{}
+	for _, apply := range opts {
+		apply(&o)
+	}
+	return o
+}
+
+// DefaultOrder appends a term to the order used when the List request doesn't specify one. By default, results are
+// ordered by ID.
+func DefaultOrder(field string, direction OrderDirection) OrderOption {
+	return func(o *order) {
+		o.Default = append(o.Default, OrderTerm{Field: field, Descending: direction == OrderDesc})
+	}
+}
+
+// TieBreaker sets the unique field appended to every order, so results are returned in a stable order. By default,
+// the ID is used.
+func TieBreaker(field string) OrderOption {
+	return func(o *order) {
+		o.TieBreaker = field
+	}
+}
+
+// OrderTerm orders the results by a field.
+type OrderTerm struct {
+	Field      string
+	Descending bool
+}
+
+type order struct {
+	Default    []OrderTerm
+	TieBreaker string
+}
+
+func (order) Name() string {
+	return OrderAnnotation
+}
+
+// ExtractOrderAnnotation returns the entproto.ListOrder annotation of the schema, with the defaults applied.
+func ExtractOrderAnnotation(sch *gen.Type) (*order, error) {
+	out := order{}
+	if annot, ok := sch.Annotations[OrderAnnotation]; ok {
+		if err := mapstructure.Decode(annot, &out); err != nil {
+			return nil, fmt.Errorf("entproto: unable to decode entproto.ListOrder annotation for schema %q: %w",
+				sch.Name, err)
+		}
+	}
+	if out.TieBreaker == "" {
+		out.TieBreaker = sch.ID.Name
+	}
+	if len(out.Default) == 0 {
+		out.Default = []OrderTerm{{Field: out.TieBreaker}}
+	}
+	return &out, nil
+}
+
+// Verify checks the fields of the annotation are sortable fields of the schema, and the tie-breaker is a unique,
+// required field.
+func (o *order) Verify(sch *gen.Type) error {
+	for _, term := range o.Default {
+		if _, err := sortableField(sch, term.Field); err != nil {
+			return err
+		}
+	}
+	fld, err := sortableField(sch, o.TieBreaker)
+	if err != nil {
+		return err
+	}
+	if fld != sch.ID && (!fld.Unique || fld.Optional || fld.Nillable) {
+		return fmt.Errorf("entproto: tie-breaker %q of schema %q must be a unique, required field", fld.Name, sch.Name)
+	}
+	return nil
+}
+
+func sortableField(sch *gen.Type, name string) (*gen.Field, error) {
+	if name == sch.ID.Name {
+		return sch.ID, nil
+	}
+	for _, fld := range sch.Fields {
+		if fld.Name != name {
+			continue
+		}
+		if !IsSortable(fld) {
+			return nil, fmt.Errorf("entproto: field %q of schema %q is not annotated with entproto.Sortable", name, sch.Name)
+		}
+		return fld, nil
+	}
+	return nil, fmt.Errorf("entproto: schema %q has no field %q to order by", sch.Name, name)
+}
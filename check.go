@@ -0,0 +1,249 @@
+package entproto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Change describes a difference between a previously generated .proto file and the descriptors generated from the
+// current schema.
+type Change struct {
+	// File is the path of the .proto file, relative to the proto directory.
+	File string
+	// Element is the full name of the changed message, field, enum, service or method.
+	Element protoreflect.FullName
+	// Description is a human-readable description of the change.
+	Description string
+	// Breaking reports whether the change breaks clients built against the previous file.
+	Breaking bool
+}
+
+func (c Change) String() string {
+	kind := "warning"
+	if c.Breaking {
+		kind = "breaking"
+	}
+	return fmt.Sprintf("%s: %s: %s [%s]", c.File, c.Element, c.Description, kind)
+}
+
+// HasBreakingChanges reports whether any of the changes is wire-incompatible.
+func HasBreakingChanges(changes []Change) bool {
+	for _, c := range changes {
+		if c.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// Check builds the descriptors of the ent graph and compares them to the .proto files previously generated under
+// protoDir. Files that don't exist yet are skipped. If protoDir is empty, the default location used by Generate is
+// checked.
+func Check(g *gen.Graph, protoDir string) ([]Change, error) {
+	if protoDir == "" {
+		protoDir = path.Join(g.Config.Target, "proto")
+	}
+	adapter, err := loadGeneratedAdapter(g)
+	if err != nil {
+		return nil, err
+	}
+	return adapter.Check(protoDir)
+}
+
+// Check compares the file descriptors of the adapter to the .proto files previously generated under protoDir.
+func (a *Adapter) Check(protoDir string) ([]Change, error) {
+	paths := make([]string, 0, len(a.descriptors))
+	for p := range a.descriptors {
+		if _, err := os.Stat(filepath.Join(protoDir, p)); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			ImportPaths: []string{protoDir},
+		}),
+	}
+	previous, err := compiler.Compile(context.Background(), paths...)
+	if err != nil {
+		return nil, fmt.Errorf("entproto: failed parsing previously generated .proto files: %w", err)
+	}
+	var changes []Change
+	for _, prev := range previous {
+		changes = append(changes, CompareFileDescriptors(prev, a.descriptors[prev.Path()])...)
+	}
+	return changes, nil
+}
+
+// CompareFileDescriptors reports the changes between two versions of the same .proto file. Additions are not
+// reported, removals and modifications are, and are flagged as breaking when they are wire-incompatible.
+func CompareFileDescriptors(prev, next protoreflect.FileDescriptor) []Change {
+	c := &comparison{file: prev.Path()}
+	c.messages(prev.Messages(), next.Messages())
+	c.enums(prev.Enums(), next.Enums())
+	c.services(prev.Services(), next.Services())
+	return c.changes
+}
+
+type comparison struct {
+	file    string
+	changes []Change
+}
+
+func (c *comparison) report(elem protoreflect.FullName, breaking bool, format string, args ...any) {
+	c.changes = append(c.changes, Change{
+		File:        c.file,
+		Element:     elem,
+		Description: fmt.Sprintf(format, args...),
+		Breaking:    breaking,
+	})
+}
+
+func (c *comparison) messages(prev, next protoreflect.MessageDescriptors) {
+	for i := 0; i < prev.Len(); i++ {
+		pm := prev.Get(i)
+		nm := next.ByName(pm.Name())
+		if nm == nil {
+			c.report(pm.FullName(), false, "message removed")
+			continue
+		}
+		c.fields(pm, nm)
+		c.messages(pm.Messages(), nm.Messages())
+		c.enums(pm.Enums(), nm.Enums())
+	}
+}
+
+func (c *comparison) fields(prev, next protoreflect.MessageDescriptor) {
+	for i := 0; i < prev.Fields().Len(); i++ {
+		pf := prev.Fields().Get(i)
+		nf := next.Fields().ByNumber(pf.Number())
+		if nf == nil {
+			switch renumbered := next.Fields().ByName(pf.Name()); {
+			case renumbered != nil:
+				c.report(pf.FullName(), true, "field number changed from %d to %d", pf.Number(), renumbered.Number())
+			case next.ReservedRanges().Has(pf.Number()):
+			default:
+				c.report(pf.FullName(), true, "field %d removed without being reserved", pf.Number())
+			}
+			continue
+		}
+		if pf.Name() != nf.Name() {
+			c.report(pf.FullName(), false, "field %d renamed to %q", pf.Number(), nf.Name())
+		}
+		if pf.Cardinality() != nf.Cardinality() {
+			c.report(pf.FullName(), true, "field %d changed from %s to %s", pf.Number(), pf.Cardinality(), nf.Cardinality())
+		}
+		if pt, nt := fieldTypeName(pf), fieldTypeName(nf); pt != nt {
+			if wireCompatible(pf, nf) {
+				c.report(pf.FullName(), false, "field %d changed type from %s to the wire-compatible %s", pf.Number(), pt, nt)
+			} else {
+				c.report(pf.FullName(), true, "field %d changed type from %s to %s", pf.Number(), pt, nt)
+			}
+		}
+	}
+}
+
+func (c *comparison) enums(prev, next protoreflect.EnumDescriptors) {
+	for i := 0; i < prev.Len(); i++ {
+		pe := prev.Get(i)
+		ne := next.ByName(pe.Name())
+		if ne == nil {
+			c.report(pe.FullName(), false, "enum removed")
+			continue
+		}
+		for j := 0; j < pe.Values().Len(); j++ {
+			pv := pe.Values().Get(j)
+			nv := ne.Values().ByNumber(pv.Number())
+			switch {
+			case nv == nil && ne.ReservedRanges().Has(pv.Number()):
+			case nv == nil:
+				c.report(pv.FullName(), true, "enum value %d removed without being reserved", pv.Number())
+			case nv.Name() != pv.Name():
+				c.report(pv.FullName(), false, "enum value %d renamed to %q", pv.Number(), nv.Name())
+			}
+		}
+	}
+}
+
+func (c *comparison) services(prev, next protoreflect.ServiceDescriptors) {
+	for i := 0; i < prev.Len(); i++ {
+		ps := prev.Get(i)
+		ns := next.ByName(ps.Name())
+		if ns == nil {
+			c.report(ps.FullName(), true, "service removed or renamed")
+			continue
+		}
+		for j := 0; j < ps.Methods().Len(); j++ {
+			pm := ps.Methods().Get(j)
+			nm := ns.Methods().ByName(pm.Name())
+			if nm == nil {
+				c.report(pm.FullName(), true, "method removed or renamed")
+				continue
+			}
+			if pm.Input().FullName() != nm.Input().FullName() {
+				c.report(pm.FullName(), true, "request type changed from %s to %s", pm.Input().FullName(), nm.Input().FullName())
+			}
+			if pm.Output().FullName() != nm.Output().FullName() {
+				c.report(pm.FullName(), true, "response type changed from %s to %s", pm.Output().FullName(), nm.Output().FullName())
+			}
+			if pm.IsStreamingClient() != nm.IsStreamingClient() || pm.IsStreamingServer() != nm.IsStreamingServer() {
+				c.report(pm.FullName(), true, "streaming mode changed")
+			}
+		}
+	}
+}
+
+func fieldTypeName(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return string(fd.Message().FullName())
+	case protoreflect.EnumKind:
+		return string(fd.Enum().FullName())
+	default:
+		return fd.Kind().String()
+	}
+}
+
+// wireGroups lists the scalar kinds sharing the same wire encoding, values of one kind can be read as another kind of
+// the same group.
+var wireGroups = map[protoreflect.Kind]int{
+	protoreflect.Int32Kind:    1,
+	protoreflect.Int64Kind:    1,
+	protoreflect.Uint32Kind:   1,
+	protoreflect.Uint64Kind:   1,
+	protoreflect.BoolKind:     1,
+	protoreflect.EnumKind:     1,
+	protoreflect.Sint32Kind:   2,
+	protoreflect.Sint64Kind:   2,
+	protoreflect.Fixed32Kind:  3,
+	protoreflect.Sfixed32Kind: 3,
+	protoreflect.Fixed64Kind:  4,
+	protoreflect.Sfixed64Kind: 4,
+	protoreflect.StringKind:   5,
+	protoreflect.BytesKind:    5,
+}
+
+func wireCompatible(prev, next protoreflect.FieldDescriptor) bool {
+	pg, ok := wireGroups[prev.Kind()]
+	if !ok {
+		return false
+	}
+	return pg == wireGroups[next.Kind()]
+}
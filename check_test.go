@@ -0,0 +1,116 @@
+package entproto
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func compileProto(src string) protoreflect.FileDescriptor {
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{"test.proto": src}),
+		}),
+	}
+	files, err := compiler.Compile(context.Background(), "test.proto")
+	So(err, ShouldBeNil)
+	return files[0]
+}
+
+func TestCompareFileDescriptors(t *testing.T) {
+	Convey("Given a previously generated file", t, func() {
+		prev := compileProto(`
+syntax = "proto3";
+package entpb;
+message User {
+  int32 id = 1;
+  string name = 2;
+  int32 age = 3;
+  bytes avatar = 4;
+  enum Status {
+    STATUS_UNSPECIFIED = 0;
+    STATUS_ACTIVE = 1;
+    STATUS_BANNED = 2;
+  }
+}
+message GetUserRequest {
+  int32 id = 1;
+}
+service UserService {
+  rpc Get ( GetUserRequest ) returns ( User );
+  rpc Delete ( GetUserRequest ) returns ( User );
+}
+`)
+
+		Convey("An identical file reports no changes", func() {
+			So(CompareFileDescriptors(prev, prev), ShouldBeEmpty)
+		})
+
+		Convey("Wire-incompatible changes are breaking", func() {
+			next := compileProto(`
+syntax = "proto3";
+package entpb;
+message User {
+  int32 id = 1;
+  string name = 5;
+  string age = 3;
+  enum Status {
+    STATUS_UNSPECIFIED = 0;
+    STATUS_ACTIVE = 1;
+  }
+}
+message GetUserRequest {
+  int64 id = 1;
+}
+service UserService {
+  rpc Get ( User ) returns ( User );
+}
+`)
+			changes := CompareFileDescriptors(prev, next)
+			So(HasBreakingChanges(changes), ShouldBeTrue)
+			descriptions := map[protoreflect.FullName]string{}
+			for _, c := range changes {
+				descriptions[c.Element] = c.Description
+			}
+			So(descriptions["entpb.User.name"], ShouldEqual, "field number changed from 2 to 5")
+			So(descriptions["entpb.User.age"], ShouldEqual, "field 3 changed type from int32 to string")
+			So(descriptions["entpb.User.avatar"], ShouldEqual, "field 4 removed without being reserved")
+			So(descriptions["entpb.User.STATUS_BANNED"], ShouldEqual, "enum value 2 removed without being reserved")
+			So(descriptions["entpb.GetUserRequest.id"], ShouldEqual, "field 1 changed type from int32 to the wire-compatible int64")
+			So(descriptions["entpb.UserService.Get"], ShouldEqual, "request type changed from entpb.GetUserRequest to entpb.User")
+			So(descriptions["entpb.UserService.Delete"], ShouldEqual, "method removed or renamed")
+		})
+
+		Convey("Reserved removals and renames are not breaking", func() {
+			next := compileProto(`
+syntax = "proto3";
+package entpb;
+message User {
+  reserved 4;
+  int32 id = 1;
+  string full_name = 2;
+  int32 age = 3;
+  enum Status {
+    reserved 2;
+    STATUS_UNSPECIFIED = 0;
+    STATUS_ACTIVE = 1;
+  }
+}
+message GetUserRequest {
+  int32 id = 1;
+}
+service UserService {
+  rpc Get ( GetUserRequest ) returns ( User );
+  rpc Delete ( GetUserRequest ) returns ( User );
+}
+`)
+			changes := CompareFileDescriptors(prev, next)
+			So(HasBreakingChanges(changes), ShouldBeFalse)
+			So(changes, ShouldHaveLength, 1)
+			So(changes[0].Description, ShouldEqual, `field 2 renamed to "full_name"`)
+		})
+	})
+}
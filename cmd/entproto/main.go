@@ -16,8 +16,11 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"log"
+	"os"
 	"path/filepath"
+	"strings"
 
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
@@ -25,24 +28,70 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "check" {
+		check(os.Args[2:])
+		return
+	}
 	var (
 		schemaPath = flag.String("path", "", "path to schema directory")
+		features   = flag.String("feature", "", "comma separated list of the features the ent client is generated with")
 	)
 	flag.Parse()
-	if *schemaPath == "" {
+	graph := loadGraph(*schemaPath, *features)
+	if err := entproto.Generate(graph); err != nil {
+		log.Fatalf("entproto: failed generating protos: %s", err)
+	}
+}
+
+// check compares the descriptors built from the schema to the committed .proto files and exits with a non-zero
+// status on wire-incompatible changes:
+//
+//	entproto check -path ./ent/schema [-proto ./ent/proto]
+func check(args []string) {
+	flags := flag.NewFlagSet("check", flag.ExitOnError)
+	var (
+		schemaPath = flags.String("path", "", "path to schema directory")
+		protoDir   = flags.String("proto", "", "path to the directory of the generated .proto files (default: <schema>/../proto)")
+		features   = flags.String("feature", "", "comma separated list of the features the ent client is generated with")
+	)
+	_ = flags.Parse(args)
+	graph := loadGraph(*schemaPath, *features)
+	changes, err := entproto.Check(graph, *protoDir)
+	if err != nil {
+		log.Fatalf("entproto: failed checking protos: %s", err)
+	}
+	if len(changes) == 0 {
+		fmt.Println("entproto: no changes to the generated protos")
+		return
+	}
+	for _, c := range changes {
+		fmt.Println(c)
+	}
+	if entproto.HasBreakingChanges(changes) {
+		fmt.Fprintln(os.Stderr, "entproto: found wire-incompatible changes")
+		os.Exit(1)
+	}
+}
+
+func loadGraph(schemaPath, features string) *gen.Graph {
+	if schemaPath == "" {
 		log.Fatal("entproto: must specify schema path. use entproto -path ./ent/schema")
 	}
-	abs, err := filepath.Abs(*schemaPath)
+	abs, err := filepath.Abs(schemaPath)
 	if err != nil {
 		log.Fatalf("entproto: failed getting absolute path: %v", err)
 	}
-	graph, err := entc.LoadGraph(*schemaPath, &gen.Config{
+	cfg := &gen.Config{
 		Target: filepath.Dir(abs),
-	})
+	}
+	if features != "" {
+		if err := entc.FeatureNames(strings.Split(features, ",")...)(cfg); err != nil {
+			log.Fatalf("entproto: failed enabling features: %v", err)
+		}
+	}
+	graph, err := entc.LoadGraph(schemaPath, cfg)
 	if err != nil {
 		log.Fatalf("entproto: failed loading ent graph: %v", err)
 	}
-	if err := entproto.Generate(graph); err != nil {
-		log.Fatalf("entproto: failed generating protos: %s", err)
-	}
+	return graph
 }
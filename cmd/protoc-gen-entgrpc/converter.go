@@ -102,10 +102,11 @@ func (g *generator) newConverter(fld *entproto.FieldMappingDescriptor, pbds ...a
 	efld := fld.EntField
 	if fld.IsEdgeField {
 		efld = fld.EntEdge.Type.ID
+		pbd = fld.EdgeIDPbStructFieldDesc()
 	}
 
 	switch {
-	case implements(efld.Type.RType, binaryMarshallerUnmarshallerType) && efld.HasGoType():
+	case implements(efld.Type.RType, binaryMarshallerUnmarshallerType) && efld.HasGoType() && !isStringKind(pbd):
 		// Ident returned from ent already has the packagename prefixed. Strip it since `g.QualifiedGoIdent`
 		// adds it back.
 		split := strings.Split(efld.Type.Ident, ".")
@@ -223,6 +224,16 @@ func convertPbMessageType(md protoreflect.MessageDescriptor, entField *gen.Field
 	return nil
 }
 
+// isStringKind reports whether the pb field holds a string, either directly or through a StringValue wrapper.
+// Types such as UUIDs that are exposed as strings are parsed through their sql.Scanner rather than unmarshalled
+// from their binary form.
+func isStringKind(pbd protoreflect.FieldDescriptor) bool {
+	if pbd.Kind() == protoreflect.MessageKind {
+		return pbd.Message().FullName() == "google.protobuf.StringValue"
+	}
+	return pbd.Kind() == protoreflect.StringKind
+}
+
 func isWrapperType(md protoreflect.MessageDescriptor) bool {
 	_, ok := wrapperPrimitives[md.FullName()]
 	return ok
@@ -31,6 +31,7 @@ import (
 var (
 	entSchemaPath  *string
 	snake          = gen.Funcs["snake"].(func(string) string)
+	pascal         = gen.Funcs["pascal"].(func(string) string)
 	connectPackage = protogen.GoImportPath("connectrpc.com/connect")
 	runtimePackage = protogen.GoImportPath("github.com/yoshino-s/entproto/runtime")
 )
@@ -41,7 +42,11 @@ func main() {
 	protogen.Options{
 		ParamFunc: flags.Set,
 	}.Run(func(plg *protogen.Plugin) error {
-		g, err := entc.LoadGraph(*entSchemaPath, &gen.Config{})
+		// The Upsert methods of the .proto files were verified against the features of the ent client when the
+		// files were generated, so the graph is loaded with the upsert feature to describe them again.
+		g, err := entc.LoadGraph(*entSchemaPath, &gen.Config{
+			Features: []gen.Feature{gen.FeatureUpsert},
+		})
 		if err != nil {
 			return err
 		}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"text/template"
@@ -11,6 +13,7 @@ import (
 	"entgo.io/ent/entc/gen"
 	entFieldPkg "entgo.io/ent/schema/field"
 	"github.com/yoshino-s/entproto"
+	"github.com/yoshino-s/entproto/annotations"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -112,6 +115,30 @@ func (g *serviceGenerator) generate() error {
 					res,
 				)
 			},
+			"callTxHook": func(action string, query string) string {
+				return fmt.Sprintf(
+					"if err := svc.RunHooks(ctx, %s, req, %s); err != nil { return err }",
+					g.QualifiedGoIdent(runtimePackage.Ident("Action"+action)),
+					query,
+				)
+			},
+			"callTxHookAfter": func(action string, res string) string {
+				return fmt.Sprintf(
+					"if err := svc.RunHooksAfter(ctx, %s, req, %s); err != nil { return err }",
+					g.QualifiedGoIdent(runtimePackage.Ident("ActionAfter"+action)),
+					res,
+				)
+			},
+			"transactional": g.transactional,
+			"softDelete": func() (*gen.Field, error) {
+				return entproto.ExtractSoftDeleteField(g.EntType)
+			},
+			"edgeSoftDelete": func(e *gen.Edge) (*gen.Field, error) {
+				return entproto.ExtractSoftDeleteField(e.Type)
+			},
+			"cursorFields": cursorFields,
+			"orderFields":  orderFields,
+			"listOrder":    listOrder,
 			"hasSuffix": func(s, suffix string) bool {
 				return strings.HasSuffix(s, suffix)
 			},
@@ -131,70 +158,41 @@ func (g *serviceGenerator) generate() error {
 				}
 				return nil
 			},
-			"getFilters": func(m *methodInput) []*filterField {
-				for _, field := range m.Method.Input.Fields {
-					if field.Desc.Name() == "filter" {
-						fields := []*filterField{}
-
-						mm := map[string]*gen.Field{}
-						for _, f := range m.G.EntType.Fields {
-							mm[f.Name] = f
-						}
-
-						for _, f := range field.Message.Fields {
-							if strings.HasSuffix(string(f.Desc.Name()), "_in") {
-								name := strings.TrimSuffix(string(f.Desc.Name()), "_in")
-								if entField, ok := mm[name]; ok {
-									typ := entField.Type.Type.String()
-									if entField.Type.Type == entFieldPkg.TypeTime {
-										typ = g.QualifiedGoIdent(protogen.GoImportPath("time").Ident("Time"))
-									} else if entField.Type.Type == entFieldPkg.TypeJSON {
-										typ = g.QualifiedGoIdent(protogen.GoImportPath("encoding/json").Ident("RawMessage"))
-									}
-									fields = append(fields, &filterField{
-										Field: &entproto.FieldMappingDescriptor{
-											EntField:          entField,
-											PbFieldDescriptor: f.Desc,
-										},
-										Operation: fmt.Sprintf("%sIn", entField.StructField()),
-										Optional:  entField.Type.Type != entFieldPkg.TypeEnum,
-										Type:      typ,
-									})
-								}
-							} else if strings.HasSuffix(string(f.Desc.Name()), "_contains") {
-								name := strings.TrimSuffix(string(f.Desc.Name()), "_contains")
-								if entField, ok := mm[name]; ok {
-									fields = append(fields, &filterField{
-										Field: &entproto.FieldMappingDescriptor{
-											EntField:          entField,
-											PbFieldDescriptor: f.Desc,
-										},
-										Operation: fmt.Sprintf("%sContains", entField.StructField()),
-										Optional:  entField.Type.Type != entFieldPkg.TypeEnum,
-									})
-								}
-							} else {
-								name := string(f.Desc.Name())
-								if entField, ok := mm[name]; ok {
-									fields = append(fields, &filterField{
-										Field: &entproto.FieldMappingDescriptor{
-											EntField:          entField,
-											PbFieldDescriptor: f.Desc,
-										},
-										Operation: fmt.Sprintf("%sEQ", entField.StructField()),
-										Optional:  entField.Type.Type != entFieldPkg.TypeEnum,
-									})
-								}
-							}
-						}
-
-						return fields
-					}
-				}
-				return nil
+			"getFilters":    g.getFilters,
+			"filterMessage": filterMessage,
+			"extraFilters":  g.extraFilters,
+			"withEdges":     g.withEdges,
+			"edgeIDsField":  edgeIDsField,
+			"maxBatchCreateSize": func() int {
+				return entproto.MaxBatchCreateSize
 			},
+			"maxBatchSize": func() int {
+				return entproto.MaxBatchSize
+			},
+			"groupFields":        groupFields,
+			"aggregateFields":    aggregateFields,
+			"aggregateFunctions": aggregateFunctions,
+			"maxAggregateMetrics": func() int {
+				return entproto.MaxAggregateMetrics
+			},
+			"maxAggregateGroups": func() int {
+				return entproto.MaxAggregateGroups
+			},
+			"goType":          g.goType,
+			"updateMethod":    g.updateMethod,
+			"listMethod":      g.listMethod,
+			"watchMethod":     g.watchMethod,
+			"watchEvents":     g.watchEvents,
+			"customMethods":   g.customMethods,
+			"isCustomMethod":  g.isCustomMethod,
+			"conflictTargets": g.conflictTargets,
+			"hasInputField":   hasInputField,
+			"messageField":    messageField,
+			"updateMask":      updateMask,
+			"defaultWith":     g.defaultWith,
 		}).
-		ParseFS(templates, "template/service/*.tmpl")
+		// The Watch method converts the IDs of the events with field_to_proto.
+		ParseFS(templates, "template/service/*.tmpl", "template/message/to_proto.tmpl")
 	if err != nil {
 		return err
 	}
@@ -213,11 +211,74 @@ type (
 		G      *serviceGenerator
 		Method *protogen.Method
 	}
+	// enumField is a value of an enum listing fields of the schema, such as <T>OrderField.
+	enumField struct {
+		Value *protogen.EnumValue
+		Field *gen.Field
+	}
+	groupField struct {
+		*enumField
+		// Path is the name of the field in the protobuf message of the schema.
+		Path string
+	}
+	aggregateFunction struct {
+		Value *protogen.EnumValue
+		// Func is the ent aggregate function computing the metric.
+		Func protogen.GoIdent
+		// HasField reports whether the function takes the field of the metric.
+		HasField bool
+	}
+	listOrderTerm struct {
+		Field      *gen.Field
+		Descending bool
+	}
+	listOrderInput struct {
+		Default    []*listOrderTerm
+		TieBreaker *gen.Field
+	}
 	filterField struct {
 		Field     *entproto.FieldMappingDescriptor
 		Operation string
-		Optional  bool
 		Type      string
+		// Edge is set for edge filters, their Operation is one of Has, EQ or In.
+		Edge *gen.Edge
+	}
+	eagerLoad struct {
+		Edge *gen.Edge
+		// QueryType is the qualified type of the query of the edge's schema.
+		QueryType string
+		// Nested are the default edges of the edge's schema, loaded along with it.
+		Nested []*eagerLoad
+	}
+	withEdge struct {
+		*eagerLoad
+		Value *protogen.EnumValue
+	}
+	updateMaskInput struct {
+		// Edges are the paths of the edges and of their fields, which the "*" path only selects when they are set.
+		Edges []string
+		// ReadOnly are the paths update masks can't list: the ID, the mask itself, the soft delete field and the
+		// operation fields of the edges.
+		ReadOnly []string
+	}
+	extraFilterField struct {
+		Field *entproto.FieldMappingDescriptor
+		// Type is the Go type of the value passed to the <T>ExtraFilterApplier.
+		Type string
+	}
+	conflictTarget struct {
+		Value *protogen.EnumValue
+		// Columns are the Go expressions of the columns passed to OnConflictColumns.
+		Columns []string
+		// Fields and Edges are the fields and the unique edges holding the columns, which the soft-deleted entities
+		// conflicting with an upsert are looked up by.
+		Fields []*gen.Field
+		Edges  []*gen.Edge
+	}
+	watchEvent struct {
+		Value *protogen.EnumValue
+		// Event is the runtime.EventType published for the value.
+		Event protogen.GoIdent
 	}
 	updateField struct {
 		EntField    *gen.Field
@@ -237,6 +298,671 @@ func extractEntTypeName(s *protogen.Service, g *gen.Graph) (*gen.Type, error) {
 	return nil, fmt.Errorf("entproto: type %q of service %q not found in graph", typeName, s.GoName)
 }
 
+// filterOperations maps the suffixes of the List<T>Filter fields to the ent predicates they generate. Fields without
+// a known suffix are compared for equality.
+var filterOperations = []struct {
+	suffix    string
+	operation string
+}{
+	{"_not_in", "NotIn"},
+	{"_in", "In"},
+	{"_contains", "Contains"},
+	{"_has_prefix", "HasPrefix"},
+	{"_has_suffix", "HasSuffix"},
+	{"_equal_fold", "EqualFold"},
+	{"_contains_fold", "ContainsFold"},
+	{"_neq", "NEQ"},
+	{"_is_nil", "IsNil"},
+	{"_gt", "GT"},
+	{"_gte", "GTE"},
+	{"_lt", "LT"},
+	{"_lte", "LTE"},
+}
+
+// hasInputField reports whether the request message of a method has a field with the given name.
+func hasInputField(m *methodInput, name string) bool {
+	return m.Method.Input.Desc.Fields().ByName(protoreflect.Name(name)) != nil
+}
+
+// messageField returns the field of a message with the given name.
+func messageField(msg *protogen.Message, name string) (*protogen.Field, error) {
+	for _, f := range msg.Fields {
+		if string(f.Desc.Name()) == name {
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("entproto: field %q of message %q not found", name, msg.Desc.FullName())
+}
+
+// edgeIDsField maps a repeated field of the request message holding IDs of the edge's neighbors, such as the
+// add_<edge>_ids field of Update<T>Request, to the ID field of the edge's schema. It returns nil if the request has no
+// such field.
+func edgeIDsField(m *methodInput, name string, e *gen.Edge) *entproto.FieldMappingDescriptor {
+	f := m.Method.Input.Desc.Fields().ByName(protoreflect.Name(name))
+	if f == nil {
+		return nil
+	}
+	return &entproto.FieldMappingDescriptor{
+		EntField:          e.Type.ID,
+		PbFieldDescriptor: f,
+	}
+}
+
+// updateMask returns the paths of an Update method passed to runtime.UpdateMaskPaths.
+func updateMask(m *methodInput) (*updateMaskInput, error) {
+	softDelete, err := entproto.ExtractSoftDeleteField(m.G.EntType)
+	if err != nil {
+		return nil, err
+	}
+	out := &updateMaskInput{
+		ReadOnly: []string{string(m.G.FieldMap.ID().PbFieldDescriptor.Name()), "update_mask"},
+	}
+	for _, f := range m.G.FieldMap.Fields() {
+		switch {
+		case softDelete != nil && f.EntField.Name == softDelete.Name:
+			out.ReadOnly = append(out.ReadOnly, string(f.PbFieldDescriptor.Name()))
+		case f.EntField.IsEdgeField():
+			// Clearing the field of an edge clears the edge.
+			out.Edges = append(out.Edges, string(f.PbFieldDescriptor.Name()))
+		}
+	}
+	for _, e := range m.G.FieldMap.Edges() {
+		path := string(e.PbFieldDescriptor.Name())
+		out.Edges = append(out.Edges, path)
+		for _, name := range []string{"clear_" + path, "add_" + path + "_ids", "remove_" + path + "_ids"} {
+			if hasInputField(m, name) {
+				out.ReadOnly = append(out.ReadOnly, name)
+			}
+		}
+	}
+	return out, nil
+}
+
+// filterMessage returns the List<T>Filter message of a List method.
+func filterMessage(m *methodInput) (*protogen.Message, error) {
+	for _, f := range m.Method.Input.Fields {
+		if f.Desc.Name() == "filter" && f.Message != nil {
+			return f.Message, nil
+		}
+	}
+	return nil, fmt.Errorf("entproto: filter message of method %q not found", m.Method.Desc.FullName())
+}
+
+// edgeFilterOperations maps the affixes of the List<T>Filter fields generated for edges to the ent predicates they
+// generate.
+var edgeFilterOperations = []struct {
+	prefix    string
+	suffix    string
+	operation string
+}{
+	{"has_", "", "Has"},
+	{"", "_id_in", "In"},
+	{"", "_id", "EQ"},
+}
+
+// getFilters maps the fields of the List<T>Filter message of a List method to the ent predicates they generate.
+func (g *serviceGenerator) getFilters(m *methodInput) []*filterField {
+	for _, field := range m.Method.Input.Fields {
+		if field.Desc.Name() != "filter" {
+			continue
+		}
+		mm := map[string]*gen.Field{}
+		for _, f := range m.G.EntType.Fields {
+			mm[f.Name] = f
+		}
+
+		em := map[string]*gen.Edge{}
+		for _, e := range m.G.EntType.Edges {
+			em[snake(e.Name)] = e
+		}
+
+		fields := []*filterField{}
+		for _, f := range field.Message.Fields {
+			name := string(f.Desc.Name())
+			entField, operation := mm[name], "EQ"
+			for _, op := range filterOperations {
+				if trimmed := strings.TrimSuffix(name, op.suffix); trimmed != name && mm[trimmed] != nil {
+					entField, operation = mm[trimmed], op.operation
+					break
+				}
+			}
+			if entField == nil {
+				if ff := g.getEdgeFilter(em, f); ff != nil {
+					fields = append(fields, ff)
+				}
+				continue
+			}
+			ff := &filterField{
+				Field: &entproto.FieldMappingDescriptor{
+					EntField:          entField,
+					PbFieldDescriptor: f.Desc,
+				},
+				Operation: entField.StructField() + operation,
+			}
+			if strings.HasSuffix(operation, "In") {
+				ff.Type = g.goType(entField.Type)
+			}
+			fields = append(fields, ff)
+		}
+		return fields
+	}
+	return nil
+}
+
+// goType returns the qualified Go type of the values of an ent field type.
+func (g *serviceGenerator) goType(t *entFieldPkg.TypeInfo) string {
+	switch t.Type {
+	case entFieldPkg.TypeTime:
+		return g.QualifiedGoIdent(protogen.GoImportPath("time").Ident("Time"))
+	case entFieldPkg.TypeJSON:
+		return g.QualifiedGoIdent(protogen.GoImportPath("encoding/json").Ident("RawMessage"))
+	}
+	if t.RType != nil && t.RType.PkgPath != "" && t.RType.Kind != reflect.Ptr {
+		return g.QualifiedGoIdent(protogen.GoImportPath(t.RType.PkgPath).Ident(t.RType.Name))
+	}
+	return t.Type.String()
+}
+
+// conflictTargets maps the values of the <T>ConflictTarget enum of an Upsert method to the columns of the unique
+// field or index they select.
+func (g *serviceGenerator) conflictTargets(m *methodInput) ([]*conflictTarget, error) {
+	var enum *protogen.Enum
+	for _, f := range m.Method.Input.Fields {
+		if f.Desc.Name() == "on_conflict" && f.Enum != nil {
+			enum = f.Enum
+		}
+	}
+	if enum == nil {
+		return nil, fmt.Errorf("entproto: on_conflict field of method %q not found", m.Method.Desc.FullName())
+	}
+	targets, err := entproto.ExtractConflictTargets(g.EntType)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*entproto.ConflictTarget, len(targets))
+	for _, t := range targets {
+		byName[t.Value] = t
+	}
+	fields := make(map[string]*gen.Field, len(g.EntType.Fields))
+	for _, f := range g.EntType.Fields {
+		fields[f.StorageKey()] = f
+	}
+	edges := make(map[string]*gen.Edge)
+	for _, e := range g.EntType.Edges {
+		if e.Unique && e.OwnFK() {
+			edges[e.Rel.Column()] = e
+		}
+	}
+	var out []*conflictTarget
+	for _, v := range enum.Values {
+		if v.Desc.Number() == 0 {
+			continue
+		}
+		t, ok := byName[string(v.Desc.Name())]
+		if !ok {
+			return nil, fmt.Errorf("entproto: conflict target %q of schema %q not found", v.Desc.Name(), g.EntType.Name)
+		}
+		ct := &conflictTarget{Value: v}
+		for _, c := range t.Columns {
+			if f, ok := fields[c]; ok {
+				ct.Columns = append(ct.Columns, g.QualifiedGoIdent(g.entIdent(g.EntType.Package(), f.Constant())))
+				ct.Fields = append(ct.Fields, f)
+				continue
+			}
+			e, ok := edges[c]
+			if !ok {
+				return nil, fmt.Errorf("entproto: column %q of conflict target %q of schema %q not found", c, v.Desc.Name(), g.EntType.Name)
+			}
+			ct.Columns = append(ct.Columns, strconv.Quote(c))
+			ct.Edges = append(ct.Edges, e)
+		}
+		out = append(out, ct)
+	}
+	return out, nil
+}
+
+// transactional reports whether the mutations of the service run inside a transaction.
+func (g *serviceGenerator) transactional() (bool, error) {
+	svcAnnotation, err := entproto.ExtractServiceAnnotation(g.EntType)
+	if err != nil {
+		return false, err
+	}
+	return svcAnnotation.Transactional, nil
+}
+
+// updateMethod returns the method the updateBuilder of the service is generated for: the Update method, or a stand-in
+// for it holding the Update<T>Request message of the BatchUpdate method. It returns nil if the service has neither.
+func (g *serviceGenerator) updateMethod() *methodInput {
+	var batch *protogen.Method
+	for _, m := range g.Service.Methods {
+		switch m.GoName {
+		case "Update":
+			return &methodInput{G: g, Method: m}
+		case "BatchUpdate":
+			batch = m
+		}
+	}
+	if batch == nil {
+		return nil
+	}
+	for _, f := range batch.Input.Fields {
+		if f.Desc.Name() == "requests" && f.Message != nil {
+			return &methodInput{G: g, Method: &protogen.Method{
+				Desc:   batch.Desc,
+				GoName: "Update",
+				Parent: batch.Parent,
+				Input:  f.Message,
+				Output: batch.Output,
+			}}
+		}
+	}
+	return nil
+}
+
+// listMethod returns the List method of the service, or its StreamList method if it has no List method. Both take the
+// List<T>Request, and share the query built from it. It returns nil if the service has neither.
+func (g *serviceGenerator) listMethod() *methodInput {
+	var stream *protogen.Method
+	for _, m := range g.Service.Methods {
+		switch m.GoName {
+		case "List":
+			return &methodInput{G: g, Method: m}
+		case "StreamList":
+			stream = m
+		}
+	}
+	if stream == nil {
+		return nil
+	}
+	return &methodInput{G: g, Method: stream}
+}
+
+// watchMethod returns the Watch method of the service, or nil if it has none. The mutation methods of services with a
+// Watch method publish their changes.
+func (g *serviceGenerator) watchMethod() *methodInput {
+	for _, m := range g.Service.Methods {
+		if m.GoName == "Watch" {
+			return &methodInput{G: g, Method: m}
+		}
+	}
+	return nil
+}
+
+// customMethods returns the methods of the service declared with entproto.RPC, delegated to its <T>CustomMethods.
+func (g *serviceGenerator) customMethods() ([]*protogen.Method, error) {
+	svcAnnotation, err := entproto.ExtractServiceAnnotation(g.EntType)
+	if err != nil {
+		return nil, err
+	}
+	var out []*protogen.Method
+	for _, m := range g.Service.Methods {
+		for _, r := range svcAnnotation.RPCs {
+			if r.Name == string(m.Desc.Name()) {
+				out = append(out, m)
+			}
+		}
+	}
+	return out, nil
+}
+
+// isCustomMethod reports whether the method of the service is declared with entproto.RPC.
+func (g *serviceGenerator) isCustomMethod(m *protogen.Method) (bool, error) {
+	custom, err := g.customMethods()
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(custom, m), nil
+}
+
+// watchEvents maps the values of the <T>WatchEventType enum of a Watch method to the runtime.EventType they report.
+func (g *serviceGenerator) watchEvents(m *methodInput) ([]*watchEvent, error) {
+	var enum *protogen.Enum
+	for _, f := range m.Method.Output.Fields {
+		if f.Desc.Name() == "type" && f.Enum != nil {
+			enum = f.Enum
+		}
+	}
+	if enum == nil {
+		return nil, fmt.Errorf("entproto: type field of method %q not found", m.Method.Desc.FullName())
+	}
+	var out []*watchEvent
+	for _, v := range enum.Values {
+		n := int(v.Desc.Number())
+		if n == 0 {
+			continue
+		}
+		if n > len(entproto.WatchEvents) {
+			return nil, fmt.Errorf("entproto: unknown watch event type %q of schema %q", v.Desc.Name(), g.EntType.Name)
+		}
+		out = append(out, &watchEvent{
+			Value: v,
+			Event: runtimePackage.Ident("Event" + pascal(entproto.WatchEvents[n-1])),
+		})
+	}
+	return out, nil
+}
+
+// extraFilters maps the fields declared with entproto.ExtraFilter to the fields of the List<T>Filter message of the
+// service. It returns nil if the service has no List or StreamList method.
+func (g *serviceGenerator) extraFilters() ([]*extraFilterField, error) {
+	list := g.listMethod()
+	if list == nil {
+		return nil, nil
+	}
+	filter, err := filterMessage(list)
+	if err != nil {
+		return nil, err
+	}
+	annot, err := entproto.ExtractExtraFilterAnnotation(g.EntType)
+	if err != nil || annot == nil {
+		return nil, err
+	}
+	var fields []*extraFilterField
+	for _, d := range annot.ExtraFields {
+		var pbField *protogen.Field
+		for _, f := range filter.Fields {
+			if string(f.Desc.Name()) == snake(d.Name) {
+				pbField = f
+			}
+		}
+		if pbField == nil {
+			return nil, fmt.Errorf("entproto: extra filter field %q not found in message %q", d.Name, filter.Desc.FullName())
+		}
+		fields = append(fields, &extraFilterField{
+			Field: &entproto.FieldMappingDescriptor{
+				EntField:          &gen.Field{Name: d.Name, Type: d.Info},
+				PbFieldDescriptor: pbField.Desc,
+			},
+			Type: g.goType(d.Info),
+		})
+	}
+	return fields, nil
+}
+
+// withEdges maps the values of the <T>Edge enum selecting the edges eager-loaded by the Get and List methods to the
+// edges they load. It returns nil if the service methods have no with field.
+func (g *serviceGenerator) withEdges() ([]*withEdge, error) {
+	var enum *protogen.Enum
+	for _, m := range g.Service.Methods {
+		for _, f := range m.Input.Fields {
+			if f.Desc.Name() == "with" && f.Enum != nil {
+				enum = f.Enum
+			}
+		}
+	}
+	if enum == nil {
+		return nil, nil
+	}
+	svcAnnotation, err := entproto.ExtractServiceAnnotation(g.EntType)
+	if err != nil {
+		return nil, err
+	}
+	edges := map[string]*gen.Edge{}
+	for _, e := range g.EntType.Edges {
+		edges[strings.ToUpper(snake(e.Name))] = e
+	}
+	prefix := strings.ToUpper(snake(string(enum.Desc.Name()))) + "_"
+	var out []*withEdge
+	for _, v := range enum.Values {
+		if v.Desc.Number() == 0 {
+			continue
+		}
+		e, ok := edges[strings.TrimPrefix(string(v.Desc.Name()), prefix)]
+		if !ok {
+			return nil, fmt.Errorf("entproto: enum value %q does not match an edge of schema %q", v.Desc.Name(), g.EntType.Name)
+		}
+		load, err := g.eagerLoad(e, svcAnnotation.MaxWithDepth-1)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &withEdge{eagerLoad: load, Value: v})
+	}
+	return out, nil
+}
+
+// defaultWith returns the edges eager-loaded when a Get or List request doesn't select any.
+func (g *serviceGenerator) defaultWith() ([]*withEdge, error) {
+	edges, err := g.withEdges()
+	if err != nil {
+		return nil, err
+	}
+	svcAnnotation, err := entproto.ExtractServiceAnnotation(g.EntType)
+	if err != nil {
+		return nil, err
+	}
+	var out []*withEdge
+	for _, name := range svcAnnotation.DefaultWith {
+		for _, e := range edges {
+			if e.Edge.Name == name {
+				out = append(out, e)
+			}
+		}
+	}
+	return out, nil
+}
+
+// eagerLoad returns the loading of an edge, along with the default edges of its schema up to the given depth.
+func (g *serviceGenerator) eagerLoad(e *gen.Edge, depth int) (*eagerLoad, error) {
+	load := &eagerLoad{
+		Edge:      e,
+		QueryType: g.QualifiedGoIdent(g.EntPackage.Ident(e.Type.Name + "Query")),
+	}
+	if depth == 0 {
+		return load, nil
+	}
+	if _, ok := e.Type.Annotations[entproto.ServiceAnnotation]; !ok {
+		return load, nil
+	}
+	svcAnnotation, err := entproto.ExtractServiceAnnotation(e.Type)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range svcAnnotation.DefaultWith {
+		for _, nested := range e.Type.Edges {
+			if nested.Name != name {
+				continue
+			}
+			nestedLoad, err := g.eagerLoad(nested, depth-1)
+			if err != nil {
+				return nil, err
+			}
+			load.Nested = append(load.Nested, nestedLoad)
+		}
+	}
+	return load, nil
+}
+
+// getEdgeFilter maps a field of the List<T>Filter message to the edge it filters by, or returns nil if the field
+// isn't an edge filter.
+func (g *serviceGenerator) getEdgeFilter(edges map[string]*gen.Edge, f *protogen.Field) *filterField {
+	name := string(f.Desc.Name())
+	for _, op := range edgeFilterOperations {
+		if !strings.HasPrefix(name, op.prefix) || !strings.HasSuffix(name, op.suffix) {
+			continue
+		}
+		e := edges[strings.TrimSuffix(strings.TrimPrefix(name, op.prefix), op.suffix)]
+		if e == nil {
+			continue
+		}
+		ff := &filterField{
+			Field: &entproto.FieldMappingDescriptor{
+				EntField:          e.Type.ID,
+				PbFieldDescriptor: f.Desc,
+			},
+			Operation: op.operation,
+			Edge:      e,
+		}
+		if op.operation == "In" {
+			ff.Type = e.Type.ID.Type.String()
+			if pkg := e.Type.ID.Type.PkgPath; pkg != "" {
+				ff.Type = g.QualifiedGoIdent(protogen.GoImportPath(pkg).Ident(
+					strings.TrimPrefix(ff.Type, e.Type.ID.Type.PkgName+".")))
+			}
+		}
+		return ff
+	}
+	return nil
+}
+
+// cursorFields returns the sortable fields whose values can be stored in List page tokens. Only required fields with
+// a stable JSON encoding are supported.
+func cursorFields(t *gen.Type) []*gen.Field {
+	var fields []*gen.Field
+	for _, f := range t.Fields {
+		if !annotations.IsSortable(f) || f.Optional || f.Nillable || f.Sensitive() || f.HasGoType() {
+			continue
+		}
+		switch f.Type.Type {
+		case entFieldPkg.TypeJSON, entFieldPkg.TypeBytes, entFieldPkg.TypeOther:
+			continue
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// orderFields maps the values of the <T>OrderField enum of a List method to the fields they order by.
+func orderFields(m *methodInput) ([]*enumField, error) {
+	var enum *protogen.Enum
+	for _, f := range m.Method.Input.Fields {
+		if f.Desc.Name() == "order" && f.Message != nil {
+			for _, of := range f.Message.Fields {
+				if of.Desc.Name() == "field" {
+					enum = of.Enum
+				}
+			}
+		}
+	}
+	if enum == nil {
+		return nil, fmt.Errorf("entproto: order field enum of method %q not found", m.Method.Desc.FullName())
+	}
+	return enumFields(enum, m.G.EntType)
+}
+
+// groupFields maps the values of the <T>GroupField enum of an Aggregate method to the fields they group by.
+func groupFields(m *methodInput) ([]*groupField, error) {
+	var enum *protogen.Enum
+	for _, f := range m.Method.Input.Fields {
+		if f.Desc.Name() == "group_by" && f.Enum != nil {
+			enum = f.Enum
+		}
+	}
+	if enum == nil {
+		return nil, fmt.Errorf("entproto: group_by field of method %q not found", m.Method.Desc.FullName())
+	}
+	fields, err := enumFields(enum, m.G.EntType)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*groupField, 0, len(fields))
+	for _, f := range fields {
+		var path string
+		for _, d := range m.G.FieldMap.Fields() {
+			if d.EntField == f.Field {
+				path = string(d.PbFieldDescriptor.Name())
+			}
+		}
+		if path == "" {
+			return nil, fmt.Errorf("entproto: groupable field %q of schema %q is not a field of its message",
+				f.Field.Name, m.G.EntType.Name)
+		}
+		out = append(out, &groupField{enumField: f, Path: path})
+	}
+	return out, nil
+}
+
+// aggregateFields maps the values of the <T>AggregateField enum of an Aggregate method to the fields they select.
+func aggregateFields(m *methodInput) ([]*enumField, error) {
+	enum, err := metricEnum(m, "field")
+	if err != nil {
+		return nil, err
+	}
+	return enumFields(enum, m.G.EntType)
+}
+
+// aggregateFunctions maps the values of the <T>AggregateFunction enum of an Aggregate method to the ent aggregate
+// functions computing them.
+func aggregateFunctions(m *methodInput) ([]*aggregateFunction, error) {
+	enum, err := metricEnum(m, "function")
+	if err != nil {
+		return nil, err
+	}
+	var out []*aggregateFunction
+	for _, v := range enum.Values {
+		n := int(v.Desc.Number())
+		if n == 0 {
+			continue
+		}
+		if n > len(entproto.AggregateFunctions) {
+			return nil, fmt.Errorf("entproto: unknown aggregate function %q of schema %q", v.Desc.Name(), m.G.EntType.Name)
+		}
+		fn := entproto.AggregateFunctions[n-1]
+		out = append(out, &aggregateFunction{
+			Value:    v,
+			Func:     m.G.EntPackage.Ident(pascal(fn)),
+			HasField: fn != "count",
+		})
+	}
+	return out, nil
+}
+
+// metricEnum returns the enum of a field of the Aggregate<T>Metric message of an Aggregate method.
+func metricEnum(m *methodInput, name protoreflect.Name) (*protogen.Enum, error) {
+	for _, f := range m.Method.Input.Fields {
+		if f.Desc.Name() != "metrics" || f.Message == nil {
+			continue
+		}
+		for _, mf := range f.Message.Fields {
+			if mf.Desc.Name() == name && mf.Enum != nil {
+				return mf.Enum, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("entproto: metric %s enum of method %q not found", name, m.Method.Desc.FullName())
+}
+
+// enumFields maps the values of an enum listing fields of the schema, named after them, to the fields.
+func enumFields(enum *protogen.Enum, t *gen.Type) ([]*enumField, error) {
+	fields := map[string]*gen.Field{}
+	for _, f := range append([]*gen.Field{t.ID}, t.Fields...) {
+		fields[strings.ToUpper(snake(f.Name))] = f
+	}
+	prefix := strings.ToUpper(snake(string(enum.Desc.Name()))) + "_"
+	var out []*enumField
+	for _, v := range enum.Values {
+		if v.Desc.Number() == 0 {
+			continue
+		}
+		f, ok := fields[strings.TrimPrefix(string(v.Desc.Name()), prefix)]
+		if !ok {
+			return nil, fmt.Errorf("entproto: enum value %q does not match a field of schema %q", v.Desc.Name(), t.Name)
+		}
+		out = append(out, &enumField{Value: v, Field: f})
+	}
+	return out, nil
+}
+
+// listOrder resolves the default order and the tie-breaker of a List method, set by the entproto.ListOrder
+// annotation of the schema.
+func listOrder(t *gen.Type) (*listOrderInput, error) {
+	annot, err := annotations.ExtractOrderAnnotation(t)
+	if err != nil {
+		return nil, err
+	}
+	if err := annot.Verify(t); err != nil {
+		return nil, err
+	}
+	fields := map[string]*gen.Field{t.ID.Name: t.ID}
+	for _, f := range t.Fields {
+		fields[f.Name] = f
+	}
+	out := &listOrderInput{TieBreaker: fields[annot.TieBreaker]}
+	for _, term := range annot.Default {
+		out.Default = append(out.Default, &listOrderTerm{Field: fields[term.Field], Descending: term.Descending})
+	}
+	return out, nil
+}
+
 func (g *serviceGenerator) entIdent(subpath string, ident string) protogen.GoIdent {
 	ip := path.Join(string(g.EntPackage), subpath)
 	return protogen.GoImportPath(ip).Ident(ident)
@@ -3,11 +3,13 @@ package convert
 import (
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema/field"
 	"github.com/yoshino-s/entproto/annotations"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
@@ -21,8 +23,12 @@ func (c *Converter) EntTypeToDescriptorProto(graph *gen.Graph, genType *gen.Type
 		return nil, ErrSchemaSkipped
 	}
 	msg := &descriptorpb.DescriptorProto{
-		Name:     &genType.Name,
-		EnumType: []*descriptorpb.EnumDescriptorProto(nil),
+		Name:         &genType.Name,
+		EnumType:     []*descriptorpb.EnumDescriptorProto(nil),
+		ReservedName: msgAnnot.ReservedNames,
+	}
+	if msg.ReservedRange, err = ReservedRanges(genType.Name, msgAnnot.Reserved); err != nil {
+		return nil, err
 	}
 	msgs := []*descriptorpb.DescriptorProto{msg}
 
@@ -90,6 +96,17 @@ func (c *Converter) EntTypeToDescriptorProto(graph *gen.Graph, genType *gen.Type
 func verifyNoDuplicateFieldNumbers(msg *descriptorpb.DescriptorProto) error {
 	mem := make(map[int32]struct{})
 	for _, fld := range msg.Field {
+		for _, r := range msg.ReservedRange {
+			if fld.GetNumber() >= r.GetStart() && fld.GetNumber() < r.GetEnd() {
+				return fmt.Errorf("entproto: field %q uses the number %d which is reserved on message %q",
+					fld.GetName(), fld.GetNumber(), msg.GetName())
+			}
+		}
+		for _, name := range msg.ReservedName {
+			if fld.GetName() == name {
+				return fmt.Errorf("entproto: field name %q is reserved on message %q", name, msg.GetName())
+			}
+		}
 		if _, seen := mem[fld.GetNumber()]; seen {
 			return fmt.Errorf("entproto: field %d already defined on message %q",
 				fld.GetNumber(), msg.GetName())
@@ -100,6 +117,36 @@ func verifyNoDuplicateFieldNumbers(msg *descriptorpb.DescriptorProto) error {
 	return nil
 }
 
+// ReservedRanges converts the reserved field numbers of a message annotation to descriptor ranges, merging
+// consecutive numbers into a single range.
+func ReservedRanges(msgName string, numbers []int) ([]*descriptorpb.DescriptorProto_ReservedRange, error) {
+	sorted := slices.Clone(numbers)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+	var ranges []*descriptorpb.DescriptorProto_ReservedRange
+	for _, num := range sorted {
+		if num == IDFieldNumber {
+			return nil, fmt.Errorf("entproto: message %q cannot reserve number 1 which is used by id", msgName)
+		}
+		if num < 1 || num > int(protowire.MaxValidNumber) {
+			return nil, fmt.Errorf("entproto: message %q reserves invalid field number %d", msgName, num)
+		}
+		if num >= int(protowire.FirstReservedNumber) && num <= int(protowire.LastReservedNumber) {
+			return nil, fmt.Errorf("entproto: message %q reserves field number %d, numbers %d to %d are reserved "+
+				"by protobuf", msgName, num, protowire.FirstReservedNumber, protowire.LastReservedNumber)
+		}
+		if n := len(ranges); n > 0 && ranges[n-1].GetEnd() == int32(num) {
+			ranges[n-1].End = ptr(int32(num) + 1)
+			continue
+		}
+		ranges = append(ranges, &descriptorpb.DescriptorProto_ReservedRange{
+			Start: ptr(int32(num)),
+			End:   ptr(int32(num) + 1),
+		})
+	}
+	return ranges, nil
+}
+
 func toProtoEnumDescriptor(fld *gen.Field) (*descriptorpb.EnumDescriptorProto, error) {
 	enumAnnotation, err := annotations.ExtractEnumAnnotation(fld)
 	if err != nil {
@@ -169,6 +216,11 @@ func (c *Converter) toProtoFieldDescriptor(f *gen.Field, msg *descriptorpb.Descr
 	return fieldDesc, nil
 }
 
+// ExtractIDFieldDescriptor returns the descriptor of the schema's ID field, as it appears on the generated message.
+func (c *Converter) ExtractIDFieldDescriptor(genType *gen.Type) (*descriptorpb.FieldDescriptorProto, error) {
+	return c.toProtoFieldDescriptor(genType.ID, nil)
+}
+
 func (c *Converter) ExtractProtoTypeDetails(f *gen.Field, msg *descriptorpb.DescriptorProto, optional ...bool) (FieldType, error) {
 	if f.Type.Type == field.TypeJSON {
 		return FieldType{
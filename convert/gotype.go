@@ -0,0 +1,139 @@
+package convert
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/schema/field"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// GoFieldTag is the struct tag holding the number of a field of a message converted from a Go struct type. Fields
+// tagged with "-" are skipped.
+const GoFieldTag = "entproto"
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+
+	// kindTypes maps the kinds of the Go scalar types to the ent field types they are converted like.
+	kindTypes = map[reflect.Kind]field.Type{
+		reflect.Bool:    field.TypeBool,
+		reflect.String:  field.TypeString,
+		reflect.Int:     field.TypeInt,
+		reflect.Int8:    field.TypeInt8,
+		reflect.Int16:   field.TypeInt16,
+		reflect.Int32:   field.TypeInt32,
+		reflect.Int64:   field.TypeInt64,
+		reflect.Uint:    field.TypeUint,
+		reflect.Uint8:   field.TypeUint8,
+		reflect.Uint16:  field.TypeUint16,
+		reflect.Uint32:  field.TypeUint32,
+		reflect.Uint64:  field.TypeUint64,
+		reflect.Float32: field.TypeFloat32,
+		reflect.Float64: field.TypeFloat64,
+	}
+)
+
+// GoTypeToDescriptorProto returns the message converted from a named Go struct type, or a pointer to one. The message
+// and the messages of the struct types it refers to are added to the file once, under the name of their Go type.
+//
+// Each exported field of the struct is converted to a field named after it in snake case, numbered after its entproto
+// struct tag. Scalars, []byte, time.Time and structs are supported, along with slices of them; pointers to scalars are
+// converted to the wrapper types of google/protobuf/wrappers.proto.
+func (c *Converter) GoTypeToDescriptorProto(t reflect.Type) (*descriptorpb.DescriptorProto, error) {
+	if t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct || t.Name() == "" || t == timeType {
+		return nil, fmt.Errorf("entproto: %v is not a named struct type", t)
+	}
+	if msg, ok := c.getMessage(t); ok {
+		return msg, nil
+	}
+	// The message is added before converting its fields, for recursive types to refer to it.
+	msg := c.addMessage(&descriptorpb.DescriptorProto{Name: ptr(t.Name())}, t)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag, ok := f.Tag.Lookup(GoFieldTag)
+		if tag == "-" {
+			continue
+		}
+		if !ok {
+			return nil, fmt.Errorf("entproto: field %s of %s is missing its number in an %s struct tag",
+				f.Name, t, GoFieldTag)
+		}
+		num, err := strconv.ParseInt(tag, 10, 32)
+		if err != nil || num < 1 {
+			return nil, fmt.Errorf("entproto: field %s of %s has invalid number %q", f.Name, t, tag)
+		}
+		fieldDesc, err := c.goFieldDescriptor(f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("entproto: field %s of %s: %w", f.Name, t, err)
+		}
+		fieldDesc.Name = ptr(snake(f.Name))
+		fieldDesc.Number = ptr(int32(num))
+		msg.Field = append(msg.Field, fieldDesc)
+	}
+	if err := verifyNoDuplicateFieldNumbers(msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// goFieldDescriptor returns the unnamed field converted from a Go type.
+func (c *Converter) goFieldDescriptor(t reflect.Type) (*descriptorpb.FieldDescriptorProto, error) {
+	switch {
+	case t == timeType:
+		return &descriptorpb.FieldDescriptorProto{
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: ptr("google.protobuf.Timestamp"),
+		}, nil
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		return &descriptorpb.FieldDescriptorProto{
+			Type: descriptorpb.FieldDescriptorProto_TYPE_BYTES.Enum(),
+		}, nil
+	case t.Kind() == reflect.Slice:
+		fieldDesc, err := c.goFieldDescriptor(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		if fieldDesc.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+			return nil, fmt.Errorf("unsupported nested slice type %s", t)
+		}
+		fieldDesc.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		return fieldDesc, nil
+	case t.Kind() == reflect.Struct:
+		msg, err := c.GoTypeToDescriptorProto(t)
+		if err != nil {
+			return nil, err
+		}
+		return &descriptorpb.FieldDescriptorProto{
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: msg.Name,
+		}, nil
+	case t.Kind() == reflect.Pointer && t.Elem().Kind() == reflect.Struct:
+		// Message fields already tell unset values apart.
+		return c.goFieldDescriptor(t.Elem())
+	case t.Kind() == reflect.Pointer:
+		fieldType, ok := kindTypes[t.Elem().Kind()]
+		if !ok {
+			return nil, fmt.Errorf("unsupported type %s", t)
+		}
+		return &descriptorpb.FieldDescriptorProto{
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: ptr(TypeMap[fieldType].OptionalType),
+		}, nil
+	}
+	fieldType, ok := kindTypes[t.Kind()]
+	if !ok {
+		return nil, fmt.Errorf("unsupported type %s", t)
+	}
+	return &descriptorpb.FieldDescriptorProto{
+		Type: TypeMap[fieldType].pbType.Enum(),
+	}, nil
+}
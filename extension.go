@@ -116,9 +116,28 @@ func (e *Extension) generate(g *gen.Graph) error {
 	if e.protoDir != "" {
 		entProtoDir = e.protoDir
 	}
+	adapter, err := loadGeneratedAdapter(g)
+	if err != nil {
+		return err
+	}
+	allDescriptors := make([]protoreflect.FileDescriptor, 0, len(adapter.AllFileDescriptors()))
+	for _, filedesc := range adapter.AllFileDescriptors() {
+		allDescriptors = append(allDescriptors, filedesc)
+	}
+	// Print the .proto files.
+	printer := &protoprint.Printer{}
+	if err = printer.PrintProtosToFileSystem(allDescriptors, entProtoDir); err != nil {
+		return fmt.Errorf("entproto: failed writing .proto files: %w", err)
+	}
+	return nil
+}
+
+// loadGeneratedAdapter loads the adapter of the graph and fails if any of the schemas opting in for generation could
+// not be parsed.
+func loadGeneratedAdapter(g *gen.Graph) (*Adapter, error) {
 	adapter, err := LoadAdapter(g)
 	if err != nil {
-		return fmt.Errorf("entproto: failed parsing ent graph: %w", err)
+		return nil, fmt.Errorf("entproto: failed parsing ent graph: %w", err)
 	}
 	var errs error
 	for _, schema := range g.Schemas {
@@ -129,18 +148,9 @@ func (e *Extension) generate(g *gen.Graph) error {
 		}
 	}
 	if errs != nil {
-		return fmt.Errorf("entproto: failed parsing some schemas: %w", errs)
-	}
-	allDescriptors := make([]protoreflect.FileDescriptor, 0, len(adapter.AllFileDescriptors()))
-	for _, filedesc := range adapter.AllFileDescriptors() {
-		allDescriptors = append(allDescriptors, filedesc)
+		return nil, fmt.Errorf("entproto: failed parsing some schemas: %w", errs)
 	}
-	// Print the .proto files.
-	printer := &protoprint.Printer{}
-	if err = printer.PrintProtosToFileSystem(allDescriptors, entProtoDir); err != nil {
-		return fmt.Errorf("entproto: failed writing .proto files: %w", err)
-	}
-	return nil
+	return adapter, nil
 }
 
 func fileExists(fpath string) bool {
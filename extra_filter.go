@@ -8,27 +8,42 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/gookit/goutil/arrutil"
+	"github.com/yoshino-s/entproto/annotations"
 )
 
 const ExtraFilterAnnotation = "ProtoExtraFilter"
 
+// ExtraFilter adds fields that do not exist on the schema to the generated List<T>Filter message. Each field
+// must be annotated with entproto.Field to pin its number in the filter message.
 func ExtraFilter(extraFields ...ent.Field) *extraFilter {
+	numbers := make(map[string]int)
 	return &extraFilter{
 		ExtraFields: arrutil.Map(extraFields, func(f ent.Field) (*field.Descriptor, bool) {
-			return f.Descriptor(), true
+			d := *f.Descriptor()
+			if num, ok := annotations.FieldNumber(d.Annotations); ok {
+				numbers[d.Name] = num
+			}
+			// Annotations can't be decoded back from the serialized graph.
+			d.Annotations = nil
+			return &d, true
 		}),
+		Numbers: numbers,
 	}
 }
 
 type extraFilter struct {
 	ExtraFields []*field.Descriptor `json:"extra_fields" mapstructure:"extra_fields"`
+	// Numbers holds the entproto.Field number of each extra field, keyed by field name. The annotations themselves
+	// do not survive the serialization of the schema graph, so they are resolved here.
+	Numbers map[string]int `json:"numbers" mapstructure:"numbers"`
 }
 
 func (f *extraFilter) Name() string {
 	return ExtraFilterAnnotation
 }
 
-func extractExtraFilterAnnotation(sch *gen.Type) (*extraFilter, error) {
+// ExtractExtraFilterAnnotation returns the entproto.ExtraFilter annotation of the schema, or nil if it has none.
+func ExtractExtraFilterAnnotation(sch *gen.Type) (*extraFilter, error) {
 	annot, ok := sch.Annotations[ExtraFilterAnnotation]
 	if !ok {
 		return nil, nil // No filter annotation present
@@ -37,7 +52,7 @@ func extractExtraFilterAnnotation(sch *gen.Type) (*extraFilter, error) {
 	var out extraFilter
 	err := mapstructure.Decode(annot, &out)
 	if err != nil {
-		return nil, fmt.Errorf("entproto: unable to decode entproto.Filter annotation for schema %q: %w",
+		return nil, fmt.Errorf("entproto: unable to decode entproto.ExtraFilter annotation for schema %q: %w",
 			sch.Name, err)
 	}
 
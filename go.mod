@@ -11,6 +11,7 @@ require (
 	github.com/google/uuid v1.6.0
 	github.com/gookit/goutil v0.7.0
 	github.com/jhump/protoreflect/v2 v2.0.0-beta.2
+	github.com/mattn/go-sqlite3 v1.14.16
 	github.com/smartystreets/goconvey v1.8.1
 	go.uber.org/multierr v1.11.0
 	go.uber.org/zap v1.27.0
@@ -11,9 +11,10 @@ var (
 	// GroupsColumns holds the columns for the "groups" table.
 	GroupsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "name", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Unique: true},
 		{Name: "metadata", Type: field.TypeJSON},
 		{Name: "tags", Type: field.TypeJSON},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 	}
 	// GroupsTable holds the schema information for the "groups" table.
 	GroupsTable = &schema.Table{
@@ -29,6 +30,7 @@ var (
 		{Name: "gender", Type: field.TypeEnum, Enums: []string{"male", "female"}},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "preferences", Type: field.TypeJSON, Nullable: true},
+		{Name: "points", Type: field.TypeInt, Default: 0},
 		{Name: "group_id", Type: field.TypeInt, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -39,7 +41,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_groups_users",
-				Columns:    []*schema.Column{UsersColumns[6]},
+				Columns:    []*schema.Column{UsersColumns[7]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
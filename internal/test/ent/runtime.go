@@ -2,8 +2,19 @@
 
 package ent
 
+import (
+	"github.com/yoshino-s/entproto/internal/test/ent/schema"
+	"github.com/yoshino-s/entproto/internal/test/ent/user"
+)
+
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescPoints is the schema descriptor for points field.
+	userDescPoints := userFields[6].Descriptor()
+	// user.DefaultPoints holds the default value on creation for the points field.
+	user.DefaultPoints = userDescPoints.Default.(int)
 }
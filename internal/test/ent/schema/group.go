@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
@@ -12,6 +14,17 @@ type GroupMetadata struct {
 	Version string `json:"version,omitempty"`
 }
 
+type MergeGroupsRequest struct {
+	IDs    []int   `entproto:"1"`
+	Name   *string `entproto:"2"`
+	Reason string  `entproto:"3"`
+}
+
+type MergeGroupsResponse struct {
+	ID       int       `entproto:"1"`
+	MergedAt time.Time `entproto:"2"`
+}
+
 type Group struct {
 	ent.Schema
 }
@@ -19,13 +32,21 @@ type Group struct {
 func (Group) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entproto.Message(),
-		entproto.Service(),
+		entproto.Service(
+			entproto.Methods(entproto.MethodAll|entproto.MethodBatchCreate|entproto.MethodBatchGet|
+				entproto.MethodBatchUpdate|entproto.MethodBatchDelete|entproto.MethodStreamList|
+				entproto.MethodUpsert|entproto.MethodWatch),
+			entproto.DefaultWith("users"),
+			entproto.RPC("Merge", MergeGroupsRequest{}, MergeGroupsResponse{}),
+		),
+		entproto.SoftDelete("deleted_at"),
 	}
 }
 
 func (Group) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").
+			Unique().
 			Annotations(
 				entproto.Field(2),
 			),
@@ -37,6 +58,12 @@ func (Group) Fields() []ent.Field {
 			Annotations(
 				entproto.Field(5),
 			),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entproto.Field(6),
+			),
 	}
 }
 
@@ -44,7 +71,13 @@ func (Group) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("users", User.Type).
 			Annotations(
-				entproto.Field(3),
+				entproto.Field(3,
+					entproto.EdgeUpdateNumbers(101, 102),
+				),
+				entproto.Filter(
+					entproto.WithFilterMode(entproto.FilterModeEQ|entproto.FilterModeHasEdge),
+					entproto.WithFilterNumber(entproto.FilterModeHasEdge, 101),
+				),
 			),
 	}
 }
@@ -15,10 +15,26 @@ type User struct {
 
 func (User) Annotations() []schema.Annotation {
 	return []schema.Annotation{
-		entproto.Message(),
-		entproto.Service(),
+		entproto.Message(
+			entproto.Reserved(9),
+			entproto.ReservedNames("nickname"),
+		),
+		entproto.Service(
+			entproto.DefaultWith("group"),
+			entproto.MaxWithDepth(2),
+			entproto.Transactional(),
+			entproto.Methods(entproto.MethodAll|entproto.MethodBatchCreate|entproto.MethodBatchGet|
+				entproto.MethodBatchUpdate|entproto.MethodBatchDelete|entproto.MethodStreamList|
+				entproto.MethodCount|entproto.MethodAggregate),
+		),
+		entproto.ListOrder(
+			entproto.DefaultOrder("created_at", entproto.OrderDesc),
+		),
 		entproto.ExtraFilter(
-			field.String("prefix"),
+			field.String("prefix").
+				Annotations(
+					entproto.Field(200),
+				),
 		),
 	}
 }
@@ -29,12 +45,22 @@ func (User) Fields() []ent.Field {
 		field.String("name").
 			Annotations(
 				entproto.Field(2),
-				entproto.Filter(entproto.WithFilterMode(entproto.FilterModeContains|entproto.FilterModeEQ|entproto.FilterModeIn)),
+				entproto.Sortable(),
+				entproto.Filter(
+					entproto.WithFilterMode(entproto.FilterModeContains|entproto.FilterModeEQ|entproto.FilterModeIn|entproto.FilterModeHasPrefix|entproto.FilterModeContainsFold),
+					entproto.WithFilterNumber(entproto.FilterModeContains, 101),
+					entproto.WithFilterNumber(entproto.FilterModeIn, 102),
+					entproto.WithFilterNumber(entproto.FilterModeHasPrefix, 109),
+					entproto.WithFilterNumber(entproto.FilterModeContainsFold, 110),
+				),
 			),
 		field.String("description").
 			Optional().
 			Annotations(
 				entproto.Field(4),
+				entproto.Filter(
+					entproto.WithFilterMode(entproto.FilterModeIsNil),
+				),
 			),
 		field.Enum("gender").
 			Values("male", "female").
@@ -44,24 +70,43 @@ func (User) Fields() []ent.Field {
 					"male":   1,
 					"female": 2,
 				}),
-				entproto.Filter(entproto.WithFilterMode(entproto.FilterModeEQ|entproto.FilterModeIn)),
+				entproto.Filter(
+					entproto.WithFilterMode(entproto.FilterModeEQ|entproto.FilterModeIn|entproto.FilterModeNEQ|entproto.FilterModeNotIn),
+					entproto.WithFilterNumber(entproto.FilterModeIn, 103),
+					entproto.WithFilterNumber(entproto.FilterModeNEQ, 107),
+					entproto.WithFilterNumber(entproto.FilterModeNotIn, 108),
+				),
+				entproto.Groupable(),
 			),
 		field.Time("created_at").
 			Immutable().
 			Annotations(
 				entproto.Field(3),
-				entproto.Filter(entproto.WithFilterMode(entproto.FilterModeEQ|entproto.FilterModeIn)),
+				entproto.Sortable(),
+				entproto.Filter(
+					entproto.WithFilterMode(entproto.FilterModeEQ|entproto.FilterModeIn|entproto.FilterModeRange),
+					entproto.WithFilterNumber(entproto.FilterModeIn, 104),
+					entproto.WithFilterNumber(entproto.FilterModeGTE, 105),
+					entproto.WithFilterNumber(entproto.FilterModeLTE, 106),
+				),
 			),
 		field.Int("group_id").
 			Optional().
 			Annotations(
 				entproto.Field(6),
+				entproto.Groupable(),
 			),
 		field.JSON("preferences", map[string]any{}).
 			Optional().
 			Annotations(
 				entproto.Field(8),
 			),
+		field.Int("points").
+			Default(0).
+			Annotations(
+				entproto.Field(10),
+				entproto.Aggregatable(),
+			),
 	}
 }
 
@@ -73,7 +118,14 @@ func (User) Edges() []ent.Edge {
 			Field("group_id").
 			Unique().
 			Annotations(
-				entproto.Field(7),
+				entproto.Field(7,
+					entproto.EdgeClearNumber(101),
+				),
+				entproto.Filter(
+					entproto.WithFilterMode(entproto.FilterModeIn|entproto.FilterModeHasEdge),
+					entproto.WithFilterNumber(entproto.FilterModeIn, 111),
+					entproto.WithFilterNumber(entproto.FilterModeHasEdge, 112),
+				),
 			),
 	}
 
@@ -31,6 +31,8 @@ type User struct {
 	GroupID int `json:"group_id,omitempty"`
 	// Preferences holds the value of the "preferences" field.
 	Preferences map[string]interface{} `json:"preferences,omitempty"`
+	// Points holds the value of the "points" field.
+	Points int `json:"points,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -64,7 +66,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldPreferences:
 			values[i] = new([]byte)
-		case user.FieldID, user.FieldGroupID:
+		case user.FieldID, user.FieldGroupID, user.FieldPoints:
 			values[i] = new(sql.NullInt64)
 		case user.FieldName, user.FieldDescription, user.FieldGender:
 			values[i] = new(sql.NullString)
@@ -129,6 +131,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field preferences: %w", err)
 				}
 			}
+		case user.FieldPoints:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field points", values[i])
+			} else if value.Valid {
+				u.Points = int(value.Int64)
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -187,6 +195,9 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("preferences=")
 	builder.WriteString(fmt.Sprintf("%v", u.Preferences))
+	builder.WriteString(", ")
+	builder.WriteString("points=")
+	builder.WriteString(fmt.Sprintf("%v", u.Points))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -75,6 +75,11 @@ func GroupID(v int) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldGroupID, v))
 }
 
+// Points applies equality check predicate on the "points" field. It's identical to PointsEQ.
+func Points(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPoints, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldName, v))
@@ -315,6 +320,46 @@ func PreferencesNotNil() predicate.User {
 	return predicate.User(sql.FieldNotNull(FieldPreferences))
 }
 
+// PointsEQ applies the EQ predicate on the "points" field.
+func PointsEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPoints, v))
+}
+
+// PointsNEQ applies the NEQ predicate on the "points" field.
+func PointsNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPoints, v))
+}
+
+// PointsIn applies the In predicate on the "points" field.
+func PointsIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPoints, vs...))
+}
+
+// PointsNotIn applies the NotIn predicate on the "points" field.
+func PointsNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPoints, vs...))
+}
+
+// PointsGT applies the GT predicate on the "points" field.
+func PointsGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPoints, v))
+}
+
+// PointsGTE applies the GTE predicate on the "points" field.
+func PointsGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPoints, v))
+}
+
+// PointsLT applies the LT predicate on the "points" field.
+func PointsLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPoints, v))
+}
+
+// PointsLTE applies the LTE predicate on the "points" field.
+func PointsLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPoints, v))
+}
+
 // HasGroup applies the HasEdge predicate on the "group" edge.
 func HasGroup() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -75,6 +75,20 @@ func (uc *UserCreate) SetPreferences(m map[string]interface{}) *UserCreate {
 	return uc
 }
 
+// SetPoints sets the "points" field.
+func (uc *UserCreate) SetPoints(i int) *UserCreate {
+	uc.mutation.SetPoints(i)
+	return uc
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePoints(i *int) *UserCreate {
+	if i != nil {
+		uc.SetPoints(*i)
+	}
+	return uc
+}
+
 // SetGroup sets the "group" edge to the Group entity.
 func (uc *UserCreate) SetGroup(g *Group) *UserCreate {
 	return uc.SetGroupID(g.ID)
@@ -87,6 +101,7 @@ func (uc *UserCreate) Mutation() *UserMutation {
 
 // Save creates the User in the database.
 func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
+	uc.defaults()
 	return withHooks(ctx, uc.sqlSave, uc.mutation, uc.hooks)
 }
 
@@ -112,6 +127,14 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (uc *UserCreate) defaults() {
+	if _, ok := uc.mutation.Points(); !ok {
+		v := user.DefaultPoints
+		uc.mutation.SetPoints(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Name(); !ok {
@@ -128,6 +151,9 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
+	if _, ok := uc.mutation.Points(); !ok {
+		return &ValidationError{Name: "points", err: errors.New(`ent: missing required field "User.points"`)}
+	}
 	return nil
 }
 
@@ -175,6 +201,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldPreferences, field.TypeJSON, value)
 		_node.Preferences = value
 	}
+	if value, ok := uc.mutation.Points(); ok {
+		_spec.SetField(user.FieldPoints, field.TypeInt, value)
+		_node.Points = value
+	}
 	if nodes := uc.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -322,6 +352,24 @@ func (u *UserUpsert) ClearPreferences() *UserUpsert {
 	return u
 }
 
+// SetPoints sets the "points" field.
+func (u *UserUpsert) SetPoints(v int) *UserUpsert {
+	u.Set(user.FieldPoints, v)
+	return u
+}
+
+// UpdatePoints sets the "points" field to the value that was provided on create.
+func (u *UserUpsert) UpdatePoints() *UserUpsert {
+	u.SetExcluded(user.FieldPoints)
+	return u
+}
+
+// AddPoints adds v to the "points" field.
+func (u *UserUpsert) AddPoints(v int) *UserUpsert {
+	u.Add(user.FieldPoints, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -458,6 +506,27 @@ func (u *UserUpsertOne) ClearPreferences() *UserUpsertOne {
 	})
 }
 
+// SetPoints sets the "points" field.
+func (u *UserUpsertOne) SetPoints(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPoints(v)
+	})
+}
+
+// AddPoints adds v to the "points" field.
+func (u *UserUpsertOne) AddPoints(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddPoints(v)
+	})
+}
+
+// UpdatePoints sets the "points" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdatePoints() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePoints()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -510,6 +579,7 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 	for i := range ucb.builders {
 		func(i int, root context.Context) {
 			builder := ucb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*UserMutation)
 				if !ok {
@@ -759,6 +829,27 @@ func (u *UserUpsertBulk) ClearPreferences() *UserUpsertBulk {
 	})
 }
 
+// SetPoints sets the "points" field.
+func (u *UserUpsertBulk) SetPoints(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetPoints(v)
+	})
+}
+
+// AddPoints adds v to the "points" field.
+func (u *UserUpsertBulk) AddPoints(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddPoints(v)
+	})
+}
+
+// UpdatePoints sets the "points" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdatePoints() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdatePoints()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -109,6 +109,27 @@ func (uu *UserUpdate) ClearPreferences() *UserUpdate {
 	return uu
 }
 
+// SetPoints sets the "points" field.
+func (uu *UserUpdate) SetPoints(i int) *UserUpdate {
+	uu.mutation.ResetPoints()
+	uu.mutation.SetPoints(i)
+	return uu
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePoints(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetPoints(*i)
+	}
+	return uu
+}
+
+// AddPoints adds i to the "points" field.
+func (uu *UserUpdate) AddPoints(i int) *UserUpdate {
+	uu.mutation.AddPoints(i)
+	return uu
+}
+
 // SetGroup sets the "group" edge to the Group entity.
 func (uu *UserUpdate) SetGroup(g *Group) *UserUpdate {
 	return uu.SetGroupID(g.ID)
@@ -198,6 +219,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.PreferencesCleared() {
 		_spec.ClearField(user.FieldPreferences, field.TypeJSON)
 	}
+	if value, ok := uu.mutation.Points(); ok {
+		_spec.SetField(user.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedPoints(); ok {
+		_spec.AddField(user.FieldPoints, field.TypeInt, value)
+	}
 	if uu.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -329,6 +356,27 @@ func (uuo *UserUpdateOne) ClearPreferences() *UserUpdateOne {
 	return uuo
 }
 
+// SetPoints sets the "points" field.
+func (uuo *UserUpdateOne) SetPoints(i int) *UserUpdateOne {
+	uuo.mutation.ResetPoints()
+	uuo.mutation.SetPoints(i)
+	return uuo
+}
+
+// SetNillablePoints sets the "points" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePoints(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetPoints(*i)
+	}
+	return uuo
+}
+
+// AddPoints adds i to the "points" field.
+func (uuo *UserUpdateOne) AddPoints(i int) *UserUpdateOne {
+	uuo.mutation.AddPoints(i)
+	return uuo
+}
+
 // SetGroup sets the "group" edge to the Group entity.
 func (uuo *UserUpdateOne) SetGroup(g *Group) *UserUpdateOne {
 	return uuo.SetGroupID(g.ID)
@@ -448,6 +496,12 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if uuo.mutation.PreferencesCleared() {
 		_spec.ClearField(user.FieldPreferences, field.TypeJSON)
 	}
+	if value, ok := uuo.mutation.Points(); ok {
+		_spec.SetField(user.FieldPoints, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedPoints(); ok {
+		_spec.AddField(user.FieldPoints, field.TypeInt, value)
+	}
 	if uuo.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
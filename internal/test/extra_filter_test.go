@@ -0,0 +1,49 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	_ "github.com/mattn/go-sqlite3"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/yoshino-s/entproto/internal/test/ent/enttest"
+	"github.com/yoshino-s/entproto/internal/test/ent/user"
+	"github.com/yoshino-s/entproto/internal/test/proto/entpb"
+	"github.com/yoshino-s/entproto/internal/test/proto/entpb/entpbservice"
+	"github.com/yoshino-s/entproto/runtime"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestExtraFiltersMissing(t *testing.T) {
+	Convey("Given a service without extra filter applier", t, func() {
+		client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+		defer client.Close()
+		ctx := context.Background()
+
+		client.User.Create().SetName("alice").SetGender(user.GenderFemale).SetCreatedAt(time.Now()).SaveX(ctx)
+		services := []*entpbservice.UserServiceHandler{
+			entpbservice.NewUserServiceHandler(client, nil),
+			{BaseService: runtime.NewBaseService(), Client: client},
+		}
+
+		Convey("Then lists setting extra fields fail with Internal", func() {
+			for _, svc := range services {
+				_, err := svc.List(ctx, connect.NewRequest(&entpb.ListUserRequest{
+					Filter: &entpb.ListUserFilter{Prefix: wrapperspb.String("a")},
+				}))
+				So(connect.CodeOf(err), ShouldEqual, connect.CodeInternal)
+			}
+		})
+		Convey("Then lists without extra fields succeed", func() {
+			for _, svc := range services {
+				res, err := svc.List(ctx, connect.NewRequest(&entpb.ListUserRequest{
+					Filter: &entpb.ListUserFilter{Name: wrapperspb.String("alice")},
+				}))
+				So(err, ShouldBeNil)
+				So(res.Msg.GetItems(), ShouldHaveLength, 1)
+			}
+		})
+	})
+}
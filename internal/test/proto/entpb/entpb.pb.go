@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
@@ -26,6 +27,447 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type GroupEdge int32
+
+const (
+	GroupEdge_GROUP_EDGE_UNSPECIFIED GroupEdge = 0
+	GroupEdge_GROUP_EDGE_USERS       GroupEdge = 3
+)
+
+// Enum value maps for GroupEdge.
+var (
+	GroupEdge_name = map[int32]string{
+		0: "GROUP_EDGE_UNSPECIFIED",
+		3: "GROUP_EDGE_USERS",
+	}
+	GroupEdge_value = map[string]int32{
+		"GROUP_EDGE_UNSPECIFIED": 0,
+		"GROUP_EDGE_USERS":       3,
+	}
+)
+
+func (x GroupEdge) Enum() *GroupEdge {
+	p := new(GroupEdge)
+	*p = x
+	return p
+}
+
+func (x GroupEdge) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupEdge) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_entpb_entpb_proto_enumTypes[0].Descriptor()
+}
+
+func (GroupEdge) Type() protoreflect.EnumType {
+	return &file_proto_entpb_entpb_proto_enumTypes[0]
+}
+
+func (x GroupEdge) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupEdge.Descriptor instead.
+func (GroupEdge) EnumDescriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{0}
+}
+
+type GroupOrderField int32
+
+const (
+	GroupOrderField_GROUP_ORDER_FIELD_UNSPECIFIED GroupOrderField = 0
+	GroupOrderField_GROUP_ORDER_FIELD_ID          GroupOrderField = 1
+)
+
+// Enum value maps for GroupOrderField.
+var (
+	GroupOrderField_name = map[int32]string{
+		0: "GROUP_ORDER_FIELD_UNSPECIFIED",
+		1: "GROUP_ORDER_FIELD_ID",
+	}
+	GroupOrderField_value = map[string]int32{
+		"GROUP_ORDER_FIELD_UNSPECIFIED": 0,
+		"GROUP_ORDER_FIELD_ID":          1,
+	}
+)
+
+func (x GroupOrderField) Enum() *GroupOrderField {
+	p := new(GroupOrderField)
+	*p = x
+	return p
+}
+
+func (x GroupOrderField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupOrderField) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_entpb_entpb_proto_enumTypes[1].Descriptor()
+}
+
+func (GroupOrderField) Type() protoreflect.EnumType {
+	return &file_proto_entpb_entpb_proto_enumTypes[1]
+}
+
+func (x GroupOrderField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupOrderField.Descriptor instead.
+func (GroupOrderField) EnumDescriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{1}
+}
+
+type GroupConflictTarget int32
+
+const (
+	GroupConflictTarget_GROUP_CONFLICT_TARGET_UNSPECIFIED GroupConflictTarget = 0
+	GroupConflictTarget_GROUP_CONFLICT_TARGET_NAME        GroupConflictTarget = 2
+)
+
+// Enum value maps for GroupConflictTarget.
+var (
+	GroupConflictTarget_name = map[int32]string{
+		0: "GROUP_CONFLICT_TARGET_UNSPECIFIED",
+		2: "GROUP_CONFLICT_TARGET_NAME",
+	}
+	GroupConflictTarget_value = map[string]int32{
+		"GROUP_CONFLICT_TARGET_UNSPECIFIED": 0,
+		"GROUP_CONFLICT_TARGET_NAME":        2,
+	}
+)
+
+func (x GroupConflictTarget) Enum() *GroupConflictTarget {
+	p := new(GroupConflictTarget)
+	*p = x
+	return p
+}
+
+func (x GroupConflictTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupConflictTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_entpb_entpb_proto_enumTypes[2].Descriptor()
+}
+
+func (GroupConflictTarget) Type() protoreflect.EnumType {
+	return &file_proto_entpb_entpb_proto_enumTypes[2]
+}
+
+func (x GroupConflictTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupConflictTarget.Descriptor instead.
+func (GroupConflictTarget) EnumDescriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{2}
+}
+
+type GroupWatchEventType int32
+
+const (
+	GroupWatchEventType_GROUP_WATCH_EVENT_TYPE_UNSPECIFIED GroupWatchEventType = 0
+	GroupWatchEventType_GROUP_WATCH_EVENT_TYPE_CREATED     GroupWatchEventType = 1
+	GroupWatchEventType_GROUP_WATCH_EVENT_TYPE_UPDATED     GroupWatchEventType = 2
+	GroupWatchEventType_GROUP_WATCH_EVENT_TYPE_DELETED     GroupWatchEventType = 3
+)
+
+// Enum value maps for GroupWatchEventType.
+var (
+	GroupWatchEventType_name = map[int32]string{
+		0: "GROUP_WATCH_EVENT_TYPE_UNSPECIFIED",
+		1: "GROUP_WATCH_EVENT_TYPE_CREATED",
+		2: "GROUP_WATCH_EVENT_TYPE_UPDATED",
+		3: "GROUP_WATCH_EVENT_TYPE_DELETED",
+	}
+	GroupWatchEventType_value = map[string]int32{
+		"GROUP_WATCH_EVENT_TYPE_UNSPECIFIED": 0,
+		"GROUP_WATCH_EVENT_TYPE_CREATED":     1,
+		"GROUP_WATCH_EVENT_TYPE_UPDATED":     2,
+		"GROUP_WATCH_EVENT_TYPE_DELETED":     3,
+	}
+)
+
+func (x GroupWatchEventType) Enum() *GroupWatchEventType {
+	p := new(GroupWatchEventType)
+	*p = x
+	return p
+}
+
+func (x GroupWatchEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupWatchEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_entpb_entpb_proto_enumTypes[3].Descriptor()
+}
+
+func (GroupWatchEventType) Type() protoreflect.EnumType {
+	return &file_proto_entpb_entpb_proto_enumTypes[3]
+}
+
+func (x GroupWatchEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupWatchEventType.Descriptor instead.
+func (GroupWatchEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{3}
+}
+
+type UserEdge int32
+
+const (
+	UserEdge_USER_EDGE_UNSPECIFIED UserEdge = 0
+	UserEdge_USER_EDGE_GROUP       UserEdge = 7
+)
+
+// Enum value maps for UserEdge.
+var (
+	UserEdge_name = map[int32]string{
+		0: "USER_EDGE_UNSPECIFIED",
+		7: "USER_EDGE_GROUP",
+	}
+	UserEdge_value = map[string]int32{
+		"USER_EDGE_UNSPECIFIED": 0,
+		"USER_EDGE_GROUP":       7,
+	}
+)
+
+func (x UserEdge) Enum() *UserEdge {
+	p := new(UserEdge)
+	*p = x
+	return p
+}
+
+func (x UserEdge) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserEdge) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_entpb_entpb_proto_enumTypes[4].Descriptor()
+}
+
+func (UserEdge) Type() protoreflect.EnumType {
+	return &file_proto_entpb_entpb_proto_enumTypes[4]
+}
+
+func (x UserEdge) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserEdge.Descriptor instead.
+func (UserEdge) EnumDescriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{4}
+}
+
+type UserOrderField int32
+
+const (
+	UserOrderField_USER_ORDER_FIELD_UNSPECIFIED UserOrderField = 0
+	UserOrderField_USER_ORDER_FIELD_ID          UserOrderField = 1
+	UserOrderField_USER_ORDER_FIELD_NAME        UserOrderField = 2
+	UserOrderField_USER_ORDER_FIELD_CREATED_AT  UserOrderField = 3
+)
+
+// Enum value maps for UserOrderField.
+var (
+	UserOrderField_name = map[int32]string{
+		0: "USER_ORDER_FIELD_UNSPECIFIED",
+		1: "USER_ORDER_FIELD_ID",
+		2: "USER_ORDER_FIELD_NAME",
+		3: "USER_ORDER_FIELD_CREATED_AT",
+	}
+	UserOrderField_value = map[string]int32{
+		"USER_ORDER_FIELD_UNSPECIFIED": 0,
+		"USER_ORDER_FIELD_ID":          1,
+		"USER_ORDER_FIELD_NAME":        2,
+		"USER_ORDER_FIELD_CREATED_AT":  3,
+	}
+)
+
+func (x UserOrderField) Enum() *UserOrderField {
+	p := new(UserOrderField)
+	*p = x
+	return p
+}
+
+func (x UserOrderField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserOrderField) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_entpb_entpb_proto_enumTypes[5].Descriptor()
+}
+
+func (UserOrderField) Type() protoreflect.EnumType {
+	return &file_proto_entpb_entpb_proto_enumTypes[5]
+}
+
+func (x UserOrderField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserOrderField.Descriptor instead.
+func (UserOrderField) EnumDescriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{5}
+}
+
+type UserGroupField int32
+
+const (
+	UserGroupField_USER_GROUP_FIELD_UNSPECIFIED UserGroupField = 0
+	UserGroupField_USER_GROUP_FIELD_GENDER      UserGroupField = 5
+	UserGroupField_USER_GROUP_FIELD_GROUP_ID    UserGroupField = 6
+)
+
+// Enum value maps for UserGroupField.
+var (
+	UserGroupField_name = map[int32]string{
+		0: "USER_GROUP_FIELD_UNSPECIFIED",
+		5: "USER_GROUP_FIELD_GENDER",
+		6: "USER_GROUP_FIELD_GROUP_ID",
+	}
+	UserGroupField_value = map[string]int32{
+		"USER_GROUP_FIELD_UNSPECIFIED": 0,
+		"USER_GROUP_FIELD_GENDER":      5,
+		"USER_GROUP_FIELD_GROUP_ID":    6,
+	}
+)
+
+func (x UserGroupField) Enum() *UserGroupField {
+	p := new(UserGroupField)
+	*p = x
+	return p
+}
+
+func (x UserGroupField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserGroupField) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_entpb_entpb_proto_enumTypes[6].Descriptor()
+}
+
+func (UserGroupField) Type() protoreflect.EnumType {
+	return &file_proto_entpb_entpb_proto_enumTypes[6]
+}
+
+func (x UserGroupField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserGroupField.Descriptor instead.
+func (UserGroupField) EnumDescriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{6}
+}
+
+type UserAggregateField int32
+
+const (
+	UserAggregateField_USER_AGGREGATE_FIELD_UNSPECIFIED UserAggregateField = 0
+	UserAggregateField_USER_AGGREGATE_FIELD_POINTS      UserAggregateField = 10
+)
+
+// Enum value maps for UserAggregateField.
+var (
+	UserAggregateField_name = map[int32]string{
+		0:  "USER_AGGREGATE_FIELD_UNSPECIFIED",
+		10: "USER_AGGREGATE_FIELD_POINTS",
+	}
+	UserAggregateField_value = map[string]int32{
+		"USER_AGGREGATE_FIELD_UNSPECIFIED": 0,
+		"USER_AGGREGATE_FIELD_POINTS":      10,
+	}
+)
+
+func (x UserAggregateField) Enum() *UserAggregateField {
+	p := new(UserAggregateField)
+	*p = x
+	return p
+}
+
+func (x UserAggregateField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserAggregateField) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_entpb_entpb_proto_enumTypes[7].Descriptor()
+}
+
+func (UserAggregateField) Type() protoreflect.EnumType {
+	return &file_proto_entpb_entpb_proto_enumTypes[7]
+}
+
+func (x UserAggregateField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserAggregateField.Descriptor instead.
+func (UserAggregateField) EnumDescriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{7}
+}
+
+type UserAggregateFunction int32
+
+const (
+	UserAggregateFunction_USER_AGGREGATE_FUNCTION_UNSPECIFIED UserAggregateFunction = 0
+	UserAggregateFunction_USER_AGGREGATE_FUNCTION_COUNT       UserAggregateFunction = 1
+	UserAggregateFunction_USER_AGGREGATE_FUNCTION_SUM         UserAggregateFunction = 2
+	UserAggregateFunction_USER_AGGREGATE_FUNCTION_MIN         UserAggregateFunction = 3
+	UserAggregateFunction_USER_AGGREGATE_FUNCTION_MAX         UserAggregateFunction = 4
+	UserAggregateFunction_USER_AGGREGATE_FUNCTION_MEAN        UserAggregateFunction = 5
+)
+
+// Enum value maps for UserAggregateFunction.
+var (
+	UserAggregateFunction_name = map[int32]string{
+		0: "USER_AGGREGATE_FUNCTION_UNSPECIFIED",
+		1: "USER_AGGREGATE_FUNCTION_COUNT",
+		2: "USER_AGGREGATE_FUNCTION_SUM",
+		3: "USER_AGGREGATE_FUNCTION_MIN",
+		4: "USER_AGGREGATE_FUNCTION_MAX",
+		5: "USER_AGGREGATE_FUNCTION_MEAN",
+	}
+	UserAggregateFunction_value = map[string]int32{
+		"USER_AGGREGATE_FUNCTION_UNSPECIFIED": 0,
+		"USER_AGGREGATE_FUNCTION_COUNT":       1,
+		"USER_AGGREGATE_FUNCTION_SUM":         2,
+		"USER_AGGREGATE_FUNCTION_MIN":         3,
+		"USER_AGGREGATE_FUNCTION_MAX":         4,
+		"USER_AGGREGATE_FUNCTION_MEAN":        5,
+	}
+)
+
+func (x UserAggregateFunction) Enum() *UserAggregateFunction {
+	p := new(UserAggregateFunction)
+	*p = x
+	return p
+}
+
+func (x UserAggregateFunction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserAggregateFunction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_entpb_entpb_proto_enumTypes[8].Descriptor()
+}
+
+func (UserAggregateFunction) Type() protoreflect.EnumType {
+	return &file_proto_entpb_entpb_proto_enumTypes[8]
+}
+
+func (x UserAggregateFunction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserAggregateFunction.Descriptor instead.
+func (UserAggregateFunction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{8}
+}
+
 type User_Gender int32
 
 const (
@@ -59,11 +501,11 @@ func (x User_Gender) String() string {
 }
 
 func (User_Gender) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_entpb_entpb_proto_enumTypes[0].Descriptor()
+	return file_proto_entpb_entpb_proto_enumTypes[9].Descriptor()
 }
 
 func (User_Gender) Type() protoreflect.EnumType {
-	return &file_proto_entpb_entpb_proto_enumTypes[0]
+	return &file_proto_entpb_entpb_proto_enumTypes[9]
 }
 
 func (x User_Gender) Number() protoreflect.EnumNumber {
@@ -72,7 +514,7 @@ func (x User_Gender) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use User_Gender.Descriptor instead.
 func (User_Gender) EnumDescriptor() ([]byte, []int) {
-	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{5, 0}
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{22, 0}
 }
 
 type Group struct {
@@ -80,11 +522,12 @@ type Group struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int32           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name     string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Metadata *structpb.Value `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Tags     *structpb.Value `protobuf:"bytes,5,opt,name=tags,proto3" json:"tags,omitempty"`
-	Users    []*User         `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	Id        int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Metadata  *structpb.Value        `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Tags      *structpb.Value        `protobuf:"bytes,5,opt,name=tags,proto3" json:"tags,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Users     []*User                `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
 }
 
 func (x *Group) Reset() {
@@ -147,6 +590,13 @@ func (x *Group) GetTags() *structpb.Value {
 	return nil
 }
 
+func (x *Group) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
 func (x *Group) GetUsers() []*User {
 	if x != nil {
 		return x.Users
@@ -154,20 +604,19 @@ func (x *Group) GetUsers() []*User {
 	return nil
 }
 
-type UpdateGroupRequest struct {
+type GetGroupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name     *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Metadata *structpb.Value         `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Tags     *structpb.Value         `protobuf:"bytes,4,opt,name=tags,proto3" json:"tags,omitempty"`
-	Users    []*User                 `protobuf:"bytes,5,rep,name=users,proto3" json:"users,omitempty"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	With        []GroupEdge            `protobuf:"varint,2,rep,packed,name=with,proto3,enum=entpb.GroupEdge" json:"with,omitempty"`
+	ReadMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	ShowDeleted bool                   `protobuf:"varint,4,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
 }
 
-func (x *UpdateGroupRequest) Reset() {
-	*x = UpdateGroupRequest{}
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_entpb_entpb_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -175,13 +624,13 @@ func (x *UpdateGroupRequest) Reset() {
 	}
 }
 
-func (x *UpdateGroupRequest) String() string {
+func (x *GetGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupRequest) ProtoMessage() {}
+func (*GetGroupRequest) ProtoMessage() {}
 
-func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_entpb_entpb_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -193,54 +642,57 @@ func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
 	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *UpdateGroupRequest) GetId() int32 {
+func (x *GetGroupRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateGroupRequest) GetName() *wrapperspb.StringValue {
+func (x *GetGroupRequest) GetWith() []GroupEdge {
 	if x != nil {
-		return x.Name
+		return x.With
 	}
 	return nil
 }
 
-func (x *UpdateGroupRequest) GetMetadata() *structpb.Value {
+func (x *GetGroupRequest) GetReadMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Metadata
+		return x.ReadMask
 	}
 	return nil
 }
 
-func (x *UpdateGroupRequest) GetTags() *structpb.Value {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
-
-func (x *UpdateGroupRequest) GetUsers() []*User {
+func (x *GetGroupRequest) GetShowDeleted() bool {
 	if x != nil {
-		return x.Users
+		return x.ShowDeleted
 	}
-	return nil
+	return false
 }
 
-type ListGroupFilter struct {
+type UpdateGroupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id             int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Metadata       *structpb.Value         `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Tags           *structpb.Value         `protobuf:"bytes,5,opt,name=tags,proto3" json:"tags,omitempty"`
+	DeletedAt      *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Users          []*User                 `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	AddUsersIds    []int32                 `protobuf:"varint,101,rep,packed,name=add_users_ids,json=addUsersIds,proto3" json:"add_users_ids,omitempty"`
+	RemoveUsersIds []int32                 `protobuf:"varint,102,rep,packed,name=remove_users_ids,json=removeUsersIds,proto3" json:"remove_users_ids,omitempty"`
+	UpdateMask     *fieldmaskpb.FieldMask  `protobuf:"bytes,1000,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 }
 
-func (x *ListGroupFilter) Reset() {
-	*x = ListGroupFilter{}
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_entpb_entpb_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -248,13 +700,13 @@ func (x *ListGroupFilter) Reset() {
 	}
 }
 
-func (x *ListGroupFilter) String() string {
+func (x *UpdateGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGroupFilter) ProtoMessage() {}
+func (*UpdateGroupRequest) ProtoMessage() {}
 
-func (x *ListGroupFilter) ProtoReflect() protoreflect.Message {
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_entpb_entpb_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -266,109 +718,132 @@ func (x *ListGroupFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGroupFilter.ProtoReflect.Descriptor instead.
-func (*ListGroupFilter) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
 	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{2}
 }
 
-type ListGroupRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Offset     *wrapperspb.Int32Value  `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit      *wrapperspb.Int32Value  `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Order      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
-	Descending bool                    `protobuf:"varint,4,opt,name=descending,proto3" json:"descending,omitempty"`
-	Filter     *ListGroupFilter        `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
-	NoLimit    bool                    `protobuf:"varint,6,opt,name=no_limit,json=noLimit,proto3" json:"no_limit,omitempty"`
-}
-
-func (x *ListGroupRequest) Reset() {
-	*x = ListGroupRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_entpb_entpb_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UpdateGroupRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
 	}
+	return 0
 }
 
-func (x *ListGroupRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateGroupRequest) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
 }
 
-func (*ListGroupRequest) ProtoMessage() {}
-
-func (x *ListGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_entpb_entpb_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UpdateGroupRequest) GetMetadata() *structpb.Value {
+	if x != nil {
+		return x.Metadata
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListGroupRequest.ProtoReflect.Descriptor instead.
-func (*ListGroupRequest) Descriptor() ([]byte, []int) {
-	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{3}
+func (x *UpdateGroupRequest) GetTags() *structpb.Value {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
 }
 
-func (x *ListGroupRequest) GetOffset() *wrapperspb.Int32Value {
+func (x *UpdateGroupRequest) GetDeletedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Offset
+		return x.DeletedAt
 	}
 	return nil
 }
 
-func (x *ListGroupRequest) GetLimit() *wrapperspb.Int32Value {
+func (x *UpdateGroupRequest) GetUsers() []*User {
 	if x != nil {
-		return x.Limit
+		return x.Users
 	}
 	return nil
 }
 
-func (x *ListGroupRequest) GetOrder() *wrapperspb.StringValue {
+func (x *UpdateGroupRequest) GetAddUsersIds() []int32 {
 	if x != nil {
-		return x.Order
+		return x.AddUsersIds
 	}
 	return nil
 }
 
-func (x *ListGroupRequest) GetDescending() bool {
+func (x *UpdateGroupRequest) GetRemoveUsersIds() []int32 {
 	if x != nil {
-		return x.Descending
+		return x.RemoveUsersIds
 	}
-	return false
+	return nil
 }
 
-func (x *ListGroupRequest) GetFilter() *ListGroupFilter {
+func (x *UpdateGroupRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Filter
+		return x.UpdateMask
 	}
 	return nil
 }
 
-func (x *ListGroupRequest) GetNoLimit() bool {
+type DeleteGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteGroupRequest) Reset() {
+	*x = DeleteGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRequest) ProtoMessage() {}
+
+func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteGroupRequest) GetId() int32 {
 	if x != nil {
-		return x.NoLimit
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-type ListGroupResponse struct {
+type ListGroupOrder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*Group `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Total int32    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Field      GroupOrderField `protobuf:"varint,1,opt,name=field,proto3,enum=entpb.GroupOrderField" json:"field,omitempty"`
+	Descending bool            `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
 }
 
-func (x *ListGroupResponse) Reset() {
-	*x = ListGroupResponse{}
+func (x *ListGroupOrder) Reset() {
+	*x = ListGroupOrder{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_entpb_entpb_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -376,13 +851,13 @@ func (x *ListGroupResponse) Reset() {
 	}
 }
 
-func (x *ListGroupResponse) String() string {
+func (x *ListGroupOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGroupResponse) ProtoMessage() {}
+func (*ListGroupOrder) ProtoMessage() {}
 
-func (x *ListGroupResponse) ProtoReflect() protoreflect.Message {
+func (x *ListGroupOrder) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_entpb_entpb_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -394,42 +869,39 @@ func (x *ListGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGroupResponse.ProtoReflect.Descriptor instead.
-func (*ListGroupResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListGroupOrder.ProtoReflect.Descriptor instead.
+func (*ListGroupOrder) Descriptor() ([]byte, []int) {
 	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListGroupResponse) GetItems() []*Group {
+func (x *ListGroupOrder) GetField() GroupOrderField {
 	if x != nil {
-		return x.Items
+		return x.Field
 	}
-	return nil
+	return GroupOrderField_GROUP_ORDER_FIELD_UNSPECIFIED
 }
 
-func (x *ListGroupResponse) GetTotal() int32 {
+func (x *ListGroupOrder) GetDescending() bool {
 	if x != nil {
-		return x.Total
+		return x.Descending
 	}
-	return 0
+	return false
 }
 
-type User struct {
+type ListGroupFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Gender      User_Gender             `protobuf:"varint,5,opt,name=gender,proto3,enum=entpb.User_Gender" json:"gender,omitempty"`
-	CreatedAt   *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	GroupId     *wrapperspb.Int32Value  `protobuf:"bytes,6,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Preferences *structpb.Value         `protobuf:"bytes,8,opt,name=preferences,proto3" json:"preferences,omitempty"`
-	Group       *Group                  `protobuf:"bytes,7,opt,name=group,proto3" json:"group,omitempty"`
+	HasUsers *wrapperspb.BoolValue  `protobuf:"bytes,101,opt,name=has_users,json=hasUsers,proto3" json:"has_users,omitempty"`
+	UsersId  *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=users_id,json=usersId,proto3" json:"users_id,omitempty"`
+	And      []*ListGroupFilter     `protobuf:"bytes,1000,rep,name=and,proto3" json:"and,omitempty"`
+	Or       []*ListGroupFilter     `protobuf:"bytes,1001,rep,name=or,proto3" json:"or,omitempty"`
+	Not      *ListGroupFilter       `protobuf:"bytes,1002,opt,name=not,proto3" json:"not,omitempty"`
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *ListGroupFilter) Reset() {
+	*x = ListGroupFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_entpb_entpb_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -437,13 +909,13 @@ func (x *User) Reset() {
 	}
 }
 
-func (x *User) String() string {
+func (x *ListGroupFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*ListGroupFilter) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
+func (x *ListGroupFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_entpb_entpb_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -455,77 +927,64 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListGroupFilter.ProtoReflect.Descriptor instead.
+func (*ListGroupFilter) Descriptor() ([]byte, []int) {
 	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *User) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *User) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *User) GetDescription() *wrapperspb.StringValue {
+func (x *ListGroupFilter) GetHasUsers() *wrapperspb.BoolValue {
 	if x != nil {
-		return x.Description
+		return x.HasUsers
 	}
 	return nil
 }
 
-func (x *User) GetGender() User_Gender {
-	if x != nil {
-		return x.Gender
-	}
-	return User_GENDER_UNSPECIFIED
-}
-
-func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ListGroupFilter) GetUsersId() *wrapperspb.Int32Value {
 	if x != nil {
-		return x.CreatedAt
+		return x.UsersId
 	}
 	return nil
 }
 
-func (x *User) GetGroupId() *wrapperspb.Int32Value {
+func (x *ListGroupFilter) GetAnd() []*ListGroupFilter {
 	if x != nil {
-		return x.GroupId
+		return x.And
 	}
 	return nil
 }
 
-func (x *User) GetPreferences() *structpb.Value {
+func (x *ListGroupFilter) GetOr() []*ListGroupFilter {
 	if x != nil {
-		return x.Preferences
+		return x.Or
 	}
 	return nil
 }
 
-func (x *User) GetGroup() *Group {
+func (x *ListGroupFilter) GetNot() *ListGroupFilter {
 	if x != nil {
-		return x.Group
+		return x.Not
 	}
 	return nil
 }
 
-type UserGenderEnumValue struct {
+type ListGroupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value User_Gender `protobuf:"varint,1,opt,name=value,proto3,enum=entpb.User_Gender" json:"value,omitempty"`
+	Offset      *wrapperspb.Int32Value `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit       *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Filter      *ListGroupFilter       `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	NoLimit     bool                   `protobuf:"varint,6,opt,name=no_limit,json=noLimit,proto3" json:"no_limit,omitempty"`
+	PageToken   string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Order       []*ListGroupOrder      `protobuf:"bytes,8,rep,name=order,proto3" json:"order,omitempty"`
+	With        []GroupEdge            `protobuf:"varint,9,rep,packed,name=with,proto3,enum=entpb.GroupEdge" json:"with,omitempty"`
+	ReadMask    *fieldmaskpb.FieldMask `protobuf:"bytes,10,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	ShowDeleted bool                   `protobuf:"varint,11,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
 }
 
-func (x *UserGenderEnumValue) Reset() {
-	*x = UserGenderEnumValue{}
+func (x *ListGroupRequest) Reset() {
+	*x = ListGroupRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_entpb_entpb_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -533,13 +992,13 @@ func (x *UserGenderEnumValue) Reset() {
 	}
 }
 
-func (x *UserGenderEnumValue) String() string {
+func (x *ListGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserGenderEnumValue) ProtoMessage() {}
+func (*ListGroupRequest) ProtoMessage() {}
 
-func (x *UserGenderEnumValue) ProtoReflect() protoreflect.Message {
+func (x *ListGroupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_entpb_entpb_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -551,130 +1010,147 @@ func (x *UserGenderEnumValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserGenderEnumValue.ProtoReflect.Descriptor instead.
-func (*UserGenderEnumValue) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListGroupRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupRequest) Descriptor() ([]byte, []int) {
 	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UserGenderEnumValue) GetValue() User_Gender {
+func (x *ListGroupRequest) GetOffset() *wrapperspb.Int32Value {
 	if x != nil {
-		return x.Value
+		return x.Offset
 	}
-	return User_GENDER_UNSPECIFIED
+	return nil
 }
 
-type UpdateUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id          int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Gender      *UserGenderEnumValue    `protobuf:"bytes,4,opt,name=gender,proto3" json:"gender,omitempty"`
-	GroupId     *wrapperspb.Int32Value  `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Preferences *structpb.Value         `protobuf:"bytes,6,opt,name=preferences,proto3" json:"preferences,omitempty"`
-	Group       *Group                  `protobuf:"bytes,7,opt,name=group,proto3" json:"group,omitempty"`
+func (x *ListGroupRequest) GetLimit() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
 }
 
-func (x *UpdateUserRequest) Reset() {
-	*x = UpdateUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_entpb_entpb_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ListGroupRequest) GetFilter() *ListGroupFilter {
+	if x != nil {
+		return x.Filter
 	}
+	return nil
 }
 
-func (x *UpdateUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListGroupRequest) GetNoLimit() bool {
+	if x != nil {
+		return x.NoLimit
+	}
+	return false
 }
 
-func (*UpdateUserRequest) ProtoMessage() {}
+func (x *ListGroupRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
 
-func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_entpb_entpb_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListGroupRequest) GetOrder() []*ListGroupOrder {
+	if x != nil {
+		return x.Order
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{7}
+	return nil
 }
 
-func (x *UpdateUserRequest) GetId() int32 {
+func (x *ListGroupRequest) GetWith() []GroupEdge {
 	if x != nil {
-		return x.Id
+		return x.With
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateUserRequest) GetName() *wrapperspb.StringValue {
+func (x *ListGroupRequest) GetReadMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Name
+		return x.ReadMask
 	}
 	return nil
 }
 
-func (x *UpdateUserRequest) GetDescription() *wrapperspb.StringValue {
+func (x *ListGroupRequest) GetShowDeleted() bool {
 	if x != nil {
-		return x.Description
+		return x.ShowDeleted
 	}
-	return nil
+	return false
 }
 
-func (x *UpdateUserRequest) GetGender() *UserGenderEnumValue {
-	if x != nil {
-		return x.Gender
+type ListGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items         []*Group `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int32    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	NextPageToken string   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListGroupResponse) Reset() {
+	*x = ListGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *UpdateUserRequest) GetGroupId() *wrapperspb.Int32Value {
+func (x *ListGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupResponse) ProtoMessage() {}
+
+func (x *ListGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListGroupResponse) GetItems() []*Group {
 	if x != nil {
-		return x.GroupId
+		return x.Items
 	}
 	return nil
 }
 
-func (x *UpdateUserRequest) GetPreferences() *structpb.Value {
+func (x *ListGroupResponse) GetTotal() int32 {
 	if x != nil {
-		return x.Preferences
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateUserRequest) GetGroup() *Group {
+func (x *ListGroupResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Group
+		return x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-type ListUserFilter struct {
+type BatchCreateGroupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         *wrapperspb.StringValue  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	NameContains *wrapperspb.StringValue  `protobuf:"bytes,2,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`
-	NameIn       []string                 `protobuf:"bytes,3,rep,name=name_in,json=nameIn,proto3" json:"name_in,omitempty"`
-	Gender       *UserGenderEnumValue     `protobuf:"bytes,4,opt,name=gender,proto3" json:"gender,omitempty"`
-	GenderIn     []User_Gender            `protobuf:"varint,5,rep,packed,name=gender_in,json=genderIn,proto3,enum=entpb.User_Gender" json:"gender_in,omitempty"`
-	CreatedAt    *timestamppb.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CreatedAtIn  []*timestamppb.Timestamp `protobuf:"bytes,7,rep,name=created_at_in,json=createdAtIn,proto3" json:"created_at_in,omitempty"`
-	Prefix       *wrapperspb.StringValue  `protobuf:"bytes,8,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Items []*Group `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *ListUserFilter) Reset() {
-	*x = ListUserFilter{}
+func (x *BatchCreateGroupRequest) Reset() {
+	*x = BatchCreateGroupRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_entpb_entpb_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -682,13 +1158,13 @@ func (x *ListUserFilter) Reset() {
 	}
 }
 
-func (x *ListUserFilter) String() string {
+func (x *BatchCreateGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserFilter) ProtoMessage() {}
+func (*BatchCreateGroupRequest) ProtoMessage() {}
 
-func (x *ListUserFilter) ProtoReflect() protoreflect.Message {
+func (x *BatchCreateGroupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_entpb_entpb_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -700,97 +1176,138 @@ func (x *ListUserFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserFilter.ProtoReflect.Descriptor instead.
-func (*ListUserFilter) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchCreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateGroupRequest) Descriptor() ([]byte, []int) {
 	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListUserFilter) GetName() *wrapperspb.StringValue {
+func (x *BatchCreateGroupRequest) GetItems() []*Group {
 	if x != nil {
-		return x.Name
+		return x.Items
 	}
 	return nil
 }
 
-func (x *ListUserFilter) GetNameContains() *wrapperspb.StringValue {
-	if x != nil {
-		return x.NameContains
-	}
-	return nil
+type BatchCreateGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Group `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *ListUserFilter) GetNameIn() []string {
-	if x != nil {
-		return x.NameIn
+func (x *BatchCreateGroupResponse) Reset() {
+	*x = BatchCreateGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ListUserFilter) GetGender() *UserGenderEnumValue {
-	if x != nil {
-		return x.Gender
+func (x *BatchCreateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateGroupResponse) ProtoMessage() {}
+
+func (x *BatchCreateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListUserFilter) GetGenderIn() []User_Gender {
+// Deprecated: Use BatchCreateGroupResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchCreateGroupResponse) GetItems() []*Group {
 	if x != nil {
-		return x.GenderIn
+		return x.Items
 	}
 	return nil
 }
 
-func (x *ListUserFilter) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
+type BatchGetGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int32 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *BatchGetGroupRequest) Reset() {
+	*x = BatchGetGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ListUserFilter) GetCreatedAtIn() []*timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAtIn
+func (x *BatchGetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetGroupRequest) ProtoMessage() {}
+
+func (x *BatchGetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListUserFilter) GetPrefix() *wrapperspb.StringValue {
+// Deprecated: Use BatchGetGroupRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchGetGroupRequest) GetIds() []int32 {
 	if x != nil {
-		return x.Prefix
+		return x.Ids
 	}
 	return nil
 }
 
-type ListUserRequest struct {
+type BatchGetGroupResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Offset     *wrapperspb.Int32Value  `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit      *wrapperspb.Int32Value  `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Order      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
-	Descending bool                    `protobuf:"varint,4,opt,name=descending,proto3" json:"descending,omitempty"`
-	Filter     *ListUserFilter         `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
-	NoLimit    bool                    `protobuf:"varint,6,opt,name=no_limit,json=noLimit,proto3" json:"no_limit,omitempty"`
+	Items      []*Group `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	MissingIds []int32  `protobuf:"varint,2,rep,packed,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
 }
 
-func (x *ListUserRequest) Reset() {
-	*x = ListUserRequest{}
+func (x *BatchGetGroupResponse) Reset() {
+	*x = BatchGetGroupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_entpb_entpb_proto_msgTypes[9]
+		mi := &file_proto_entpb_entpb_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUserRequest) String() string {
+func (x *BatchGetGroupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserRequest) ProtoMessage() {}
+func (*BatchGetGroupResponse) ProtoMessage() {}
 
-func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_entpb_entpb_proto_msgTypes[9]
+func (x *BatchGetGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -801,79 +1318,97 @@ func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserRequest.ProtoReflect.Descriptor instead.
-func (*ListUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use BatchGetGroupResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListUserRequest) GetOffset() *wrapperspb.Int32Value {
+func (x *BatchGetGroupResponse) GetItems() []*Group {
 	if x != nil {
-		return x.Offset
+		return x.Items
 	}
 	return nil
 }
 
-func (x *ListUserRequest) GetLimit() *wrapperspb.Int32Value {
+func (x *BatchGetGroupResponse) GetMissingIds() []int32 {
 	if x != nil {
-		return x.Limit
+		return x.MissingIds
 	}
 	return nil
 }
 
-func (x *ListUserRequest) GetOrder() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Order
-	}
-	return nil
+type BatchUpdateGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*UpdateGroupRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *ListUserRequest) GetDescending() bool {
-	if x != nil {
-		return x.Descending
+func (x *BatchUpdateGroupRequest) Reset() {
+	*x = BatchUpdateGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *ListUserRequest) GetFilter() *ListUserFilter {
-	if x != nil {
-		return x.Filter
+func (x *BatchUpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateGroupRequest) ProtoMessage() {}
+
+func (x *BatchUpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListUserRequest) GetNoLimit() bool {
+// Deprecated: Use BatchUpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchUpdateGroupRequest) GetRequests() []*UpdateGroupRequest {
 	if x != nil {
-		return x.NoLimit
+		return x.Requests
 	}
-	return false
+	return nil
 }
 
-type ListUserResponse struct {
+type BatchUpdateGroupResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*User `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	Total int32   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Items []*Group `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *ListUserResponse) Reset() {
-	*x = ListUserResponse{}
+func (x *BatchUpdateGroupResponse) Reset() {
+	*x = BatchUpdateGroupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_entpb_entpb_proto_msgTypes[10]
+		mi := &file_proto_entpb_entpb_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUserResponse) String() string {
+func (x *BatchUpdateGroupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserResponse) ProtoMessage() {}
+func (*BatchUpdateGroupResponse) ProtoMessage() {}
 
-func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_entpb_entpb_proto_msgTypes[10]
+func (x *BatchUpdateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -884,234 +1419,2573 @@ func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserResponse.ProtoReflect.Descriptor instead.
-func (*ListUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use BatchUpdateGroupResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListUserResponse) GetItems() []*User {
+func (x *BatchUpdateGroupResponse) GetItems() []*Group {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-func (x *ListUserResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
+type BatchDeleteGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int32 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *BatchDeleteGroupRequest) Reset() {
+	*x = BatchDeleteGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-var File_proto_entpb_entpb_proto protoreflect.FileDescriptor
+func (x *BatchDeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_proto_entpb_entpb_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2f, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72,
-	0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xae, 0x01, 0x0a,
-	0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2a,
-	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0xd9, 0x01,
-	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x61,
-	0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
-	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x99, 0x02, 0x0a,
-	0x10, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a,
-	0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a,
-	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a,
-	0x08, 0x6e, 0x6f, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x6e, 0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4d, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xad, 0x03, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x2e, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+func (*BatchDeleteGroupRequest) ProtoMessage() {}
+
+func (x *BatchDeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchDeleteGroupRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type StreamListGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Group `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *StreamListGroupResponse) Reset() {
+	*x = StreamListGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamListGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamListGroupResponse) ProtoMessage() {}
+
+func (x *StreamListGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamListGroupResponse.ProtoReflect.Descriptor instead.
+func (*StreamListGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StreamListGroupResponse) GetItems() []*Group {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpsertGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group      *Group              `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	OnConflict GroupConflictTarget `protobuf:"varint,2,opt,name=on_conflict,json=onConflict,proto3,enum=entpb.GroupConflictTarget" json:"on_conflict,omitempty"`
+}
+
+func (x *UpsertGroupRequest) Reset() {
+	*x = UpsertGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpsertGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertGroupRequest) ProtoMessage() {}
+
+func (x *UpsertGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpsertGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpsertGroupRequest) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *UpsertGroupRequest) GetOnConflict() GroupConflictTarget {
+	if x != nil {
+		return x.OnConflict
+	}
+	return GroupConflictTarget_GROUP_CONFLICT_TARGET_UNSPECIFIED
+}
+
+type WatchGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *ListGroupFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *WatchGroupRequest) Reset() {
+	*x = WatchGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGroupRequest) ProtoMessage() {}
+
+func (x *WatchGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGroupRequest.ProtoReflect.Descriptor instead.
+func (*WatchGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WatchGroupRequest) GetFilter() *ListGroupFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type WatchGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  GroupWatchEventType `protobuf:"varint,1,opt,name=type,proto3,enum=entpb.GroupWatchEventType" json:"type,omitempty"`
+	Id    int32               `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Group *Group              `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *WatchGroupResponse) Reset() {
+	*x = WatchGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGroupResponse) ProtoMessage() {}
+
+func (x *WatchGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGroupResponse.ProtoReflect.Descriptor instead.
+func (*WatchGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WatchGroupResponse) GetType() GroupWatchEventType {
+	if x != nil {
+		return x.Type
+	}
+	return GroupWatchEventType_GROUP_WATCH_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchGroupResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WatchGroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type UndeleteGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UndeleteGroupRequest) Reset() {
+	*x = UndeleteGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteGroupRequest) ProtoMessage() {}
+
+func (x *UndeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UndeleteGroupRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MergeGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids    []int32                 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Name   *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Reason string                  `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *MergeGroupsRequest) Reset() {
+	*x = MergeGroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGroupsRequest) ProtoMessage() {}
+
+func (x *MergeGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGroupsRequest.ProtoReflect.Descriptor instead.
+func (*MergeGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MergeGroupsRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *MergeGroupsRequest) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *MergeGroupsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MergeGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MergedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+}
+
+func (x *MergeGroupsResponse) Reset() {
+	*x = MergeGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGroupsResponse) ProtoMessage() {}
+
+func (x *MergeGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGroupsResponse.ProtoReflect.Descriptor instead.
+func (*MergeGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MergeGroupsResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MergeGroupsResponse) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Gender      User_Gender             `protobuf:"varint,5,opt,name=gender,proto3,enum=entpb.User_Gender" json:"gender,omitempty"`
+	CreatedAt   *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	GroupId     *wrapperspb.Int32Value  `protobuf:"bytes,6,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Preferences *structpb.Value         `protobuf:"bytes,8,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	Points      int32                   `protobuf:"varint,10,opt,name=points,proto3" json:"points,omitempty"`
+	Group       *Group                  `protobuf:"bytes,7,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *User) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *User) GetGender() User_Gender {
+	if x != nil {
+		return x.Gender
+	}
+	return User_GENDER_UNSPECIFIED
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetGroupId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.GroupId
+	}
+	return nil
+}
+
+func (x *User) GetPreferences() *structpb.Value {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *User) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *User) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type UserGenderEnumValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value User_Gender `protobuf:"varint,1,opt,name=value,proto3,enum=entpb.User_Gender" json:"value,omitempty"`
+}
+
+func (x *UserGenderEnumValue) Reset() {
+	*x = UserGenderEnumValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserGenderEnumValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGenderEnumValue) ProtoMessage() {}
+
+func (x *UserGenderEnumValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGenderEnumValue.ProtoReflect.Descriptor instead.
+func (*UserGenderEnumValue) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UserGenderEnumValue) GetValue() User_Gender {
+	if x != nil {
+		return x.Value
+	}
+	return User_GENDER_UNSPECIFIED
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	With     []UserEdge             `protobuf:"varint,2,rep,packed,name=with,proto3,enum=entpb.UserEdge" json:"with,omitempty"`
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetUserRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetUserRequest) GetWith() []UserEdge {
+	if x != nil {
+		return x.With
+	}
+	return nil
+}
+
+func (x *GetUserRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type UpdateUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Gender      *UserGenderEnumValue    `protobuf:"bytes,5,opt,name=gender,proto3" json:"gender,omitempty"`
+	GroupId     *wrapperspb.Int32Value  `protobuf:"bytes,6,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Preferences *structpb.Value         `protobuf:"bytes,8,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	Points      *wrapperspb.Int32Value  `protobuf:"bytes,10,opt,name=points,proto3" json:"points,omitempty"`
+	Group       *Group                  `protobuf:"bytes,7,opt,name=group,proto3" json:"group,omitempty"`
+	ClearGroup  bool                    `protobuf:"varint,101,opt,name=clear_group,json=clearGroup,proto3" json:"clear_group,omitempty"`
+	UpdateMask  *fieldmaskpb.FieldMask  `protobuf:"bytes,1000,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateUserRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetGender() *UserGenderEnumValue {
+	if x != nil {
+		return x.Gender
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetGroupId() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.GroupId
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetPreferences() *structpb.Value {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetPoints() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetClearGroup() bool {
+	if x != nil {
+		return x.ClearGroup
+	}
+	return false
+}
+
+func (x *UpdateUserRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteUserRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListUserOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field      UserOrderField `protobuf:"varint,1,opt,name=field,proto3,enum=entpb.UserOrderField" json:"field,omitempty"`
+	Descending bool           `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+}
+
+func (x *ListUserOrder) Reset() {
+	*x = ListUserOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserOrder) ProtoMessage() {}
+
+func (x *ListUserOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserOrder.ProtoReflect.Descriptor instead.
+func (*ListUserOrder) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListUserOrder) GetField() UserOrderField {
+	if x != nil {
+		return x.Field
+	}
+	return UserOrderField_USER_ORDER_FIELD_UNSPECIFIED
+}
+
+func (x *ListUserOrder) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type ListUserFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             *wrapperspb.StringValue  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	NameContains     *wrapperspb.StringValue  `protobuf:"bytes,101,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`
+	NameHasPrefix    *wrapperspb.StringValue  `protobuf:"bytes,109,opt,name=name_has_prefix,json=nameHasPrefix,proto3" json:"name_has_prefix,omitempty"`
+	NameContainsFold *wrapperspb.StringValue  `protobuf:"bytes,110,opt,name=name_contains_fold,json=nameContainsFold,proto3" json:"name_contains_fold,omitempty"`
+	NameIn           []string                 `protobuf:"bytes,102,rep,name=name_in,json=nameIn,proto3" json:"name_in,omitempty"`
+	DescriptionIsNil *wrapperspb.BoolValue    `protobuf:"bytes,4,opt,name=description_is_nil,json=descriptionIsNil,proto3" json:"description_is_nil,omitempty"`
+	Gender           *UserGenderEnumValue     `protobuf:"bytes,5,opt,name=gender,proto3" json:"gender,omitempty"`
+	GenderIn         []User_Gender            `protobuf:"varint,103,rep,packed,name=gender_in,json=genderIn,proto3,enum=entpb.User_Gender" json:"gender_in,omitempty"`
+	GenderNeq        *UserGenderEnumValue     `protobuf:"bytes,107,opt,name=gender_neq,json=genderNeq,proto3" json:"gender_neq,omitempty"`
+	GenderNotIn      []User_Gender            `protobuf:"varint,108,rep,packed,name=gender_not_in,json=genderNotIn,proto3,enum=entpb.User_Gender" json:"gender_not_in,omitempty"`
+	CreatedAt        *timestamppb.Timestamp   `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedAtIn      []*timestamppb.Timestamp `protobuf:"bytes,104,rep,name=created_at_in,json=createdAtIn,proto3" json:"created_at_in,omitempty"`
+	CreatedAtGte     *timestamppb.Timestamp   `protobuf:"bytes,105,opt,name=created_at_gte,json=createdAtGte,proto3" json:"created_at_gte,omitempty"`
+	CreatedAtLte     *timestamppb.Timestamp   `protobuf:"bytes,106,opt,name=created_at_lte,json=createdAtLte,proto3" json:"created_at_lte,omitempty"`
+	HasGroup         *wrapperspb.BoolValue    `protobuf:"bytes,112,opt,name=has_group,json=hasGroup,proto3" json:"has_group,omitempty"`
+	GroupIdIn        []int32                  `protobuf:"varint,111,rep,packed,name=group_id_in,json=groupIdIn,proto3" json:"group_id_in,omitempty"`
+	Prefix           *wrapperspb.StringValue  `protobuf:"bytes,200,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	And              []*ListUserFilter        `protobuf:"bytes,1000,rep,name=and,proto3" json:"and,omitempty"`
+	Or               []*ListUserFilter        `protobuf:"bytes,1001,rep,name=or,proto3" json:"or,omitempty"`
+	Not              *ListUserFilter          `protobuf:"bytes,1002,opt,name=not,proto3" json:"not,omitempty"`
+}
+
+func (x *ListUserFilter) Reset() {
+	*x = ListUserFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserFilter) ProtoMessage() {}
+
+func (x *ListUserFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserFilter.ProtoReflect.Descriptor instead.
+func (*ListUserFilter) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListUserFilter) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetNameContains() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NameContains
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetNameHasPrefix() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NameHasPrefix
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetNameContainsFold() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NameContainsFold
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetNameIn() []string {
+	if x != nil {
+		return x.NameIn
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetDescriptionIsNil() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.DescriptionIsNil
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetGender() *UserGenderEnumValue {
+	if x != nil {
+		return x.Gender
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetGenderIn() []User_Gender {
+	if x != nil {
+		return x.GenderIn
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetGenderNeq() *UserGenderEnumValue {
+	if x != nil {
+		return x.GenderNeq
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetGenderNotIn() []User_Gender {
+	if x != nil {
+		return x.GenderNotIn
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetCreatedAtIn() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtIn
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetCreatedAtGte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtGte
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetCreatedAtLte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtLte
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetHasGroup() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.HasGroup
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetGroupIdIn() []int32 {
+	if x != nil {
+		return x.GroupIdIn
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetPrefix() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetAnd() []*ListUserFilter {
+	if x != nil {
+		return x.And
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetOr() []*ListUserFilter {
+	if x != nil {
+		return x.Or
+	}
+	return nil
+}
+
+func (x *ListUserFilter) GetNot() *ListUserFilter {
+	if x != nil {
+		return x.Not
+	}
+	return nil
+}
+
+type ListUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset    *wrapperspb.Int32Value `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit     *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Filter    *ListUserFilter        `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	NoLimit   bool                   `protobuf:"varint,6,opt,name=no_limit,json=noLimit,proto3" json:"no_limit,omitempty"`
+	PageToken string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Order     []*ListUserOrder       `protobuf:"bytes,8,rep,name=order,proto3" json:"order,omitempty"`
+	With      []UserEdge             `protobuf:"varint,9,rep,packed,name=with,proto3,enum=entpb.UserEdge" json:"with,omitempty"`
+	ReadMask  *fieldmaskpb.FieldMask `protobuf:"bytes,10,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+}
+
+func (x *ListUserRequest) Reset() {
+	*x = ListUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserRequest) ProtoMessage() {}
+
+func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserRequest.ProtoReflect.Descriptor instead.
+func (*ListUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListUserRequest) GetOffset() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Offset
+	}
+	return nil
+}
+
+func (x *ListUserRequest) GetLimit() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *ListUserRequest) GetFilter() *ListUserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListUserRequest) GetNoLimit() bool {
+	if x != nil {
+		return x.NoLimit
+	}
+	return false
+}
+
+func (x *ListUserRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUserRequest) GetOrder() []*ListUserOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ListUserRequest) GetWith() []UserEdge {
+	if x != nil {
+		return x.With
+	}
+	return nil
+}
+
+func (x *ListUserRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type ListUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items         []*User `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int32   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	NextPageToken string  `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListUserResponse) Reset() {
+	*x = ListUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserResponse) ProtoMessage() {}
+
+func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserResponse.ProtoReflect.Descriptor instead.
+func (*ListUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListUserResponse) GetItems() []*User {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListUserResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListUserResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type BatchCreateUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*User `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *BatchCreateUserRequest) Reset() {
+	*x = BatchCreateUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateUserRequest) ProtoMessage() {}
+
+func (x *BatchCreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateUserRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BatchCreateUserRequest) GetItems() []*User {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BatchCreateUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*User `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *BatchCreateUserResponse) Reset() {
+	*x = BatchCreateUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateUserResponse) ProtoMessage() {}
+
+func (x *BatchCreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateUserResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BatchCreateUserResponse) GetItems() []*User {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BatchGetUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int32 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *BatchGetUserRequest) Reset() {
+	*x = BatchGetUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUserRequest) ProtoMessage() {}
+
+func (x *BatchGetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUserRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BatchGetUserRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items      []*User `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	MissingIds []int32 `protobuf:"varint,2,rep,packed,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
+}
+
+func (x *BatchGetUserResponse) Reset() {
+	*x = BatchGetUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUserResponse) ProtoMessage() {}
+
+func (x *BatchGetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUserResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BatchGetUserResponse) GetItems() []*User {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchGetUserResponse) GetMissingIds() []int32 {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+type BatchUpdateUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*UpdateUserRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *BatchUpdateUserRequest) Reset() {
+	*x = BatchUpdateUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateUserRequest) ProtoMessage() {}
+
+func (x *BatchUpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BatchUpdateUserRequest) GetRequests() []*UpdateUserRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchUpdateUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*User `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *BatchUpdateUserResponse) Reset() {
+	*x = BatchUpdateUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateUserResponse) ProtoMessage() {}
+
+func (x *BatchUpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateUserResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BatchUpdateUserResponse) GetItems() []*User {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BatchDeleteUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int32 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *BatchDeleteUserRequest) Reset() {
+	*x = BatchDeleteUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteUserRequest) ProtoMessage() {}
+
+func (x *BatchDeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BatchDeleteUserRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type StreamListUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*User `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *StreamListUserResponse) Reset() {
+	*x = StreamListUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamListUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamListUserResponse) ProtoMessage() {}
+
+func (x *StreamListUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamListUserResponse.ProtoReflect.Descriptor instead.
+func (*StreamListUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StreamListUserResponse) GetItems() []*User {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CountUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *ListUserFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *CountUserRequest) Reset() {
+	*x = CountUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountUserRequest) ProtoMessage() {}
+
+func (x *CountUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountUserRequest.ProtoReflect.Descriptor instead.
+func (*CountUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CountUserRequest) GetFilter() *ListUserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type CountUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountUserResponse) Reset() {
+	*x = CountUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountUserResponse) ProtoMessage() {}
+
+func (x *CountUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountUserResponse.ProtoReflect.Descriptor instead.
+func (*CountUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CountUserResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AggregateUserMetric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Function UserAggregateFunction `protobuf:"varint,1,opt,name=function,proto3,enum=entpb.UserAggregateFunction" json:"function,omitempty"`
+	Field    UserAggregateField    `protobuf:"varint,2,opt,name=field,proto3,enum=entpb.UserAggregateField" json:"field,omitempty"`
+}
+
+func (x *AggregateUserMetric) Reset() {
+	*x = AggregateUserMetric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateUserMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateUserMetric) ProtoMessage() {}
+
+func (x *AggregateUserMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateUserMetric.ProtoReflect.Descriptor instead.
+func (*AggregateUserMetric) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AggregateUserMetric) GetFunction() UserAggregateFunction {
+	if x != nil {
+		return x.Function
+	}
+	return UserAggregateFunction_USER_AGGREGATE_FUNCTION_UNSPECIFIED
+}
+
+func (x *AggregateUserMetric) GetField() UserAggregateField {
+	if x != nil {
+		return x.Field
+	}
+	return UserAggregateField_USER_AGGREGATE_FIELD_UNSPECIFIED
+}
+
+type AggregateUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter  *ListUserFilter        `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	GroupBy []UserGroupField       `protobuf:"varint,2,rep,packed,name=group_by,json=groupBy,proto3,enum=entpb.UserGroupField" json:"group_by,omitempty"`
+	Metrics []*AggregateUserMetric `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (x *AggregateUserRequest) Reset() {
+	*x = AggregateUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateUserRequest) ProtoMessage() {}
+
+func (x *AggregateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateUserRequest.ProtoReflect.Descriptor instead.
+func (*AggregateUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AggregateUserRequest) GetFilter() *ListUserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *AggregateUserRequest) GetGroupBy() []UserGroupField {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *AggregateUserRequest) GetMetrics() []*AggregateUserMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type AggregateUserGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    *User             `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values []*structpb.Value `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *AggregateUserGroup) Reset() {
+	*x = AggregateUserGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateUserGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateUserGroup) ProtoMessage() {}
+
+func (x *AggregateUserGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateUserGroup.ProtoReflect.Descriptor instead.
+func (*AggregateUserGroup) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AggregateUserGroup) GetKey() *User {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *AggregateUserGroup) GetValues() []*structpb.Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type AggregateUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*AggregateUserGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *AggregateUserResponse) Reset() {
+	*x = AggregateUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_entpb_entpb_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateUserResponse) ProtoMessage() {}
+
+func (x *AggregateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_entpb_entpb_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateUserResponse.ProtoReflect.Descriptor instead.
+func (*AggregateUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_entpb_entpb_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AggregateUserResponse) GetGroups() []*AggregateUserGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+var File_proto_entpb_entpb_proto protoreflect.FileDescriptor
+
+var file_proto_entpb_entpb_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2f, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe9,
+	0x01, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x2a, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24,
+	0x0a, 0x04, 0x77, 0x69, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x64, 0x67, 0x65, 0x52, 0x04,
+	0x77, 0x69, 0x74, 0x68, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x22, 0xa0, 0x03, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x0a,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x22, 0x0a,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x65, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b,
+	0x61, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x49, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x66, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x49, 0x64, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x73, 0x6b, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5e, 0x0a, 0x0e, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73,
+	0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64,
+	0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x81, 0x02, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a,
+	0x09, 0x68, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x68, 0x61,
+	0x73, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x73, 0x49, 0x64, 0x12, 0x29,
+	0x0a, 0x03, 0x61, 0x6e, 0x64, 0x18, 0xe8, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x03, 0x61, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x02, 0x6f, 0x72, 0x18,
+	0xe9, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x02,
+	0x6f, 0x72, 0x12, 0x29, 0x0a, 0x03, 0x6e, 0x6f, 0x74, 0x18, 0xea, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x03, 0x6e, 0x6f, 0x74, 0x22, 0xa5, 0x03,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x24, 0x0a, 0x04, 0x77, 0x69, 0x74, 0x68, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x64, 0x67,
+	0x65, 0x52, 0x04, 0x77, 0x69, 0x74, 0x68, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x05, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x75, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3d, 0x0a, 0x17,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3e, 0x0a, 0x18, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x28, 0x0a, 0x14, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x5c, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x49, 0x64, 0x73, 0x22, 0x50, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x3e, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x2b, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69,
+	0x64, 0x73, 0x22, 0x3d, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x75, 0x0a, 0x12, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3b, 0x0a, 0x0b, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x0a, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x22, 0x43, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x78, 0x0a,
+	0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x26, 0x0a, 0x14, 0x55, 0x6e, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x70, 0x0a, 0x12, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x5e, 0x0a, 0x13, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xd5, 0x03, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a,
+	0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x38, 0x0a,
+	0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x22, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x22, 0x44, 0x0a, 0x06, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x12, 0x47, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f,
+	0x4d, 0x41, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x45, 0x4e, 0x44, 0x45, 0x52,
+	0x5f, 0x46, 0x45, 0x4d, 0x41, 0x4c, 0x45, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x52,
+	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x13, 0x55, 0x73, 0x65,
+	0x72, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x7e, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x04,
+	0x77, 0x69, 0x74, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x67, 0x65, 0x52, 0x04, 0x77, 0x69, 0x74,
+	0x68, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x83, 0x04, 0x0a, 0x11, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
+	0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x38,
+	0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a,
 	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65,
 	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x22, 0x44, 0x0a, 0x06, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x12, 0x47,
-	0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41,
-	0x4c, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x46,
-	0x45, 0x4d, 0x41, 0x4c, 0x45, 0x10, 0x02, 0x22, 0x3f, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x47,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xdf, 0x02, 0x0a, 0x11, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x3e, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x32, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x65, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x3c, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b,
+	0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5c, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x22, 0xed, 0x08, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6e,
+	0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x6e,
+	0x61, 0x6d, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x6d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x48, 0x61, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x4a, 0x0a, 0x12, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x73, 0x5f, 0x66, 0x6f, 0x6c, 0x64, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x6e, 0x61, 0x6d,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x46, 0x6f, 0x6c, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x18, 0x66, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x6e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x12, 0x48, 0x0a, 0x12, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x73, 0x5f, 0x6e, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x73, 0x4e, 0x69, 0x6c,
+	0x12, 0x32, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x6e,
 	0x64, 0x65, 0x72, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x67, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x0b,
-	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xb4, 0x03, 0x0a, 0x0e, 0x4c,
-	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x41, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x67,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x45, 0x6e,
-	0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
-	0x2f, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x2e,
-	0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x6e,
-	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x49, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x70,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x22, 0x97, 0x02, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x32, 0x0a,
-	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4b, 0x0a, 0x10, 0x4c,
-	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x21, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x32, 0x9d, 0x02, 0x0a, 0x0c, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x12, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x1a, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
-	0x35, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x1a, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x31, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3d, 0x0a, 0x06, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x32, 0x95, 0x02, 0x0a, 0x0b, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x12, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a,
-	0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x03,
-	0x47, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x1a, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x03, 0x90,
-	0x02, 0x01, 0x12, 0x2f, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x2e,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x6e, 0x18, 0x67, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x08, 0x67, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x49, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f,
+	0x6e, 0x65, 0x71, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x75, 0x6d,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x65, 0x71,
+	0x12, 0x36, 0x0a, 0x0d, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69,
+	0x6e, 0x18, 0x6c, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x67, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x5f, 0x69, 0x6e, 0x18, 0x68, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x49, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x5f, 0x67, 0x74, 0x65, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x47, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x5f, 0x6c, 0x74, 0x65, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x4c, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x68, 0x61, 0x73, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x70, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x68, 0x61, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x1e, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x18,
+	0x6f, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x49, 0x6e,
+	0x12, 0x35, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x28, 0x0a, 0x03, 0x61, 0x6e, 0x64, 0x18, 0xe8,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x03, 0x61, 0x6e,
+	0x64, 0x12, 0x26, 0x0a, 0x02, 0x6f, 0x72, 0x18, 0xe9, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x02, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x03, 0x6e, 0x6f, 0x74,
+	0x18, 0xea, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x03,
+	0x6e, 0x6f, 0x74, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0xfe, 0x02, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x31, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19,
+	0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x6e, 0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2a, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x04, 0x77, 0x69, 0x74, 0x68, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45,
+	0x64, 0x67, 0x65, 0x52, 0x04, 0x77, 0x69, 0x74, 0x68, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x05, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x22, 0x73, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3b, 0x0a, 0x16, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3c, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x27, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x5a, 0x0a,
+	0x14, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x73, 0x22, 0x4e, 0x0a, 0x16, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x3c, 0x0a, 0x17, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x2a, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03,
+	0x69, 0x64, 0x73, 0x22, 0x3b, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x22, 0x41, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x22, 0x29, 0x0a, 0x11, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x80,
+	0x01, 0x0a, 0x13, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x38, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x46, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2f, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x22, 0xad, 0x01, 0x0a, 0x14, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x08, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x22, 0x63, 0x0a, 0x12, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1d, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x15, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x31, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x2a, 0x3d, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x64, 0x67, 0x65, 0x12,
+	0x1a, 0x0a, 0x16, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x47,
+	0x52, 0x4f, 0x55, 0x50, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x53, 0x10,
+	0x03, 0x2a, 0x4e, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x21, 0x0a, 0x1d, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x52, 0x4f, 0x55, 0x50,
+	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x49, 0x44, 0x10,
+	0x01, 0x2a, 0x5c, 0x0a, 0x13, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x21, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45,
+	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1e, 0x0a, 0x1a, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43,
+	0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x02, 0x2a,
+	0xa9, 0x01, 0x0a, 0x13, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x22, 0x47, 0x52, 0x4f, 0x55, 0x50,
+	0x5f, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x22, 0x0a, 0x1e, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x57, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x47, 0x52, 0x4f, 0x55, 0x50,
+	0x5f, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x3a, 0x0a, 0x08, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x64, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x45, 0x44, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f,
+	0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x07, 0x2a, 0x87, 0x01, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x1c, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44,
+	0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x02,
+	0x12, 0x1f, 0x0a, 0x1b, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x46,
+	0x49, 0x45, 0x4c, 0x44, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10,
+	0x03, 0x2a, 0x6e, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x1c, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x47, 0x52,
+	0x4f, 0x55, 0x50, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x47, 0x45, 0x4e, 0x44, 0x45, 0x52,
+	0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50,
+	0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x49, 0x44, 0x10,
+	0x06, 0x2a, 0x5b, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x24, 0x0a, 0x20, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a,
+	0x1b, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f,
+	0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53, 0x10, 0x0a, 0x2a, 0xe8,
+	0x01, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x23, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x21, 0x0a, 0x1d, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47,
+	0x41, 0x54, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x47, 0x47,
+	0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41, 0x47,
+	0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4d, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x41,
+	0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4d, 0x41, 0x58, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x05, 0x32, 0x86, 0x07, 0x0a, 0x0c, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x1a, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x30, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x03, 0x90,
+	0x02, 0x01, 0x12, 0x31, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3b, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x3c, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01,
-	0x42, 0x84, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x42, 0x0a,
-	0x45, 0x6e, 0x74, 0x70, 0x62, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x6f, 0x73, 0x68, 0x69, 0x6e, 0x6f,
-	0x2d, 0x73, 0x2f, 0x65, 0x6e, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x45, 0x6e,
-	0x74, 0x70, 0x62, 0xca, 0x02, 0x05, 0x45, 0x6e, 0x74, 0x70, 0x62, 0xe2, 0x02, 0x11, 0x45, 0x6e,
-	0x74, 0x70, 0x62, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x05, 0x45, 0x6e, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x79, 0x12, 0x3e, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90,
+	0x02, 0x01, 0x12, 0x4e, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x12, 0x1b,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x4e,
+	0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
+	0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4c, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02,
+	0x01, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x06, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x19, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x43, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x08, 0x55,
+	0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x3e, 0x0a, 0x05, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x19, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0x92, 0x06, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x2e, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x15, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x2f, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x18, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02,
+	0x01, 0x12, 0x4c, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x48, 0x0a, 0x08, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x4c, 0x0a, 0x0b, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4a, 0x0a,
+	0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x3f, 0x0a, 0x05, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x17, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x4b, 0x0a, 0x09, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x42, 0x84, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x42, 0x0a, 0x45, 0x6e, 0x74, 0x70, 0x62, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x79, 0x6f, 0x73, 0x68, 0x69, 0x6e, 0x6f, 0x2d, 0x73, 0x2f, 0x65, 0x6e, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x6e, 0x74, 0x70, 0x62, 0xa2, 0x02, 0x03, 0x45,
+	0x58, 0x58, 0xaa, 0x02, 0x05, 0x45, 0x6e, 0x74, 0x70, 0x62, 0xca, 0x02, 0x05, 0x45, 0x6e, 0x74,
+	0x70, 0x62, 0xe2, 0x02, 0x11, 0x45, 0x6e, 0x74, 0x70, 0x62, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x45, 0x6e, 0x74, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1126,90 +4000,226 @@ func file_proto_entpb_entpb_proto_rawDescGZIP() []byte {
 	return file_proto_entpb_entpb_proto_rawDescData
 }
 
-var file_proto_entpb_entpb_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_entpb_entpb_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_entpb_entpb_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_proto_entpb_entpb_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_proto_entpb_entpb_proto_goTypes = []any{
-	(User_Gender)(0),               // 0: entpb.User.Gender
-	(*Group)(nil),                  // 1: entpb.Group
-	(*UpdateGroupRequest)(nil),     // 2: entpb.UpdateGroupRequest
-	(*ListGroupFilter)(nil),        // 3: entpb.ListGroupFilter
-	(*ListGroupRequest)(nil),       // 4: entpb.ListGroupRequest
-	(*ListGroupResponse)(nil),      // 5: entpb.ListGroupResponse
-	(*User)(nil),                   // 6: entpb.User
-	(*UserGenderEnumValue)(nil),    // 7: entpb.UserGenderEnumValue
-	(*UpdateUserRequest)(nil),      // 8: entpb.UpdateUserRequest
-	(*ListUserFilter)(nil),         // 9: entpb.ListUserFilter
-	(*ListUserRequest)(nil),        // 10: entpb.ListUserRequest
-	(*ListUserResponse)(nil),       // 11: entpb.ListUserResponse
-	(*structpb.Value)(nil),         // 12: google.protobuf.Value
-	(*wrapperspb.StringValue)(nil), // 13: google.protobuf.StringValue
-	(*wrapperspb.Int32Value)(nil),  // 14: google.protobuf.Int32Value
-	(*timestamppb.Timestamp)(nil),  // 15: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),          // 16: google.protobuf.Empty
+	(GroupEdge)(0),                   // 0: entpb.GroupEdge
+	(GroupOrderField)(0),             // 1: entpb.GroupOrderField
+	(GroupConflictTarget)(0),         // 2: entpb.GroupConflictTarget
+	(GroupWatchEventType)(0),         // 3: entpb.GroupWatchEventType
+	(UserEdge)(0),                    // 4: entpb.UserEdge
+	(UserOrderField)(0),              // 5: entpb.UserOrderField
+	(UserGroupField)(0),              // 6: entpb.UserGroupField
+	(UserAggregateField)(0),          // 7: entpb.UserAggregateField
+	(UserAggregateFunction)(0),       // 8: entpb.UserAggregateFunction
+	(User_Gender)(0),                 // 9: entpb.User.Gender
+	(*Group)(nil),                    // 10: entpb.Group
+	(*GetGroupRequest)(nil),          // 11: entpb.GetGroupRequest
+	(*UpdateGroupRequest)(nil),       // 12: entpb.UpdateGroupRequest
+	(*DeleteGroupRequest)(nil),       // 13: entpb.DeleteGroupRequest
+	(*ListGroupOrder)(nil),           // 14: entpb.ListGroupOrder
+	(*ListGroupFilter)(nil),          // 15: entpb.ListGroupFilter
+	(*ListGroupRequest)(nil),         // 16: entpb.ListGroupRequest
+	(*ListGroupResponse)(nil),        // 17: entpb.ListGroupResponse
+	(*BatchCreateGroupRequest)(nil),  // 18: entpb.BatchCreateGroupRequest
+	(*BatchCreateGroupResponse)(nil), // 19: entpb.BatchCreateGroupResponse
+	(*BatchGetGroupRequest)(nil),     // 20: entpb.BatchGetGroupRequest
+	(*BatchGetGroupResponse)(nil),    // 21: entpb.BatchGetGroupResponse
+	(*BatchUpdateGroupRequest)(nil),  // 22: entpb.BatchUpdateGroupRequest
+	(*BatchUpdateGroupResponse)(nil), // 23: entpb.BatchUpdateGroupResponse
+	(*BatchDeleteGroupRequest)(nil),  // 24: entpb.BatchDeleteGroupRequest
+	(*StreamListGroupResponse)(nil),  // 25: entpb.StreamListGroupResponse
+	(*UpsertGroupRequest)(nil),       // 26: entpb.UpsertGroupRequest
+	(*WatchGroupRequest)(nil),        // 27: entpb.WatchGroupRequest
+	(*WatchGroupResponse)(nil),       // 28: entpb.WatchGroupResponse
+	(*UndeleteGroupRequest)(nil),     // 29: entpb.UndeleteGroupRequest
+	(*MergeGroupsRequest)(nil),       // 30: entpb.MergeGroupsRequest
+	(*MergeGroupsResponse)(nil),      // 31: entpb.MergeGroupsResponse
+	(*User)(nil),                     // 32: entpb.User
+	(*UserGenderEnumValue)(nil),      // 33: entpb.UserGenderEnumValue
+	(*GetUserRequest)(nil),           // 34: entpb.GetUserRequest
+	(*UpdateUserRequest)(nil),        // 35: entpb.UpdateUserRequest
+	(*DeleteUserRequest)(nil),        // 36: entpb.DeleteUserRequest
+	(*ListUserOrder)(nil),            // 37: entpb.ListUserOrder
+	(*ListUserFilter)(nil),           // 38: entpb.ListUserFilter
+	(*ListUserRequest)(nil),          // 39: entpb.ListUserRequest
+	(*ListUserResponse)(nil),         // 40: entpb.ListUserResponse
+	(*BatchCreateUserRequest)(nil),   // 41: entpb.BatchCreateUserRequest
+	(*BatchCreateUserResponse)(nil),  // 42: entpb.BatchCreateUserResponse
+	(*BatchGetUserRequest)(nil),      // 43: entpb.BatchGetUserRequest
+	(*BatchGetUserResponse)(nil),     // 44: entpb.BatchGetUserResponse
+	(*BatchUpdateUserRequest)(nil),   // 45: entpb.BatchUpdateUserRequest
+	(*BatchUpdateUserResponse)(nil),  // 46: entpb.BatchUpdateUserResponse
+	(*BatchDeleteUserRequest)(nil),   // 47: entpb.BatchDeleteUserRequest
+	(*StreamListUserResponse)(nil),   // 48: entpb.StreamListUserResponse
+	(*CountUserRequest)(nil),         // 49: entpb.CountUserRequest
+	(*CountUserResponse)(nil),        // 50: entpb.CountUserResponse
+	(*AggregateUserMetric)(nil),      // 51: entpb.AggregateUserMetric
+	(*AggregateUserRequest)(nil),     // 52: entpb.AggregateUserRequest
+	(*AggregateUserGroup)(nil),       // 53: entpb.AggregateUserGroup
+	(*AggregateUserResponse)(nil),    // 54: entpb.AggregateUserResponse
+	(*structpb.Value)(nil),           // 55: google.protobuf.Value
+	(*timestamppb.Timestamp)(nil),    // 56: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),    // 57: google.protobuf.FieldMask
+	(*wrapperspb.StringValue)(nil),   // 58: google.protobuf.StringValue
+	(*wrapperspb.BoolValue)(nil),     // 59: google.protobuf.BoolValue
+	(*wrapperspb.Int32Value)(nil),    // 60: google.protobuf.Int32Value
+	(*emptypb.Empty)(nil),            // 61: google.protobuf.Empty
 }
 var file_proto_entpb_entpb_proto_depIdxs = []int32{
-	12, // 0: entpb.Group.metadata:type_name -> google.protobuf.Value
-	12, // 1: entpb.Group.tags:type_name -> google.protobuf.Value
-	6,  // 2: entpb.Group.users:type_name -> entpb.User
-	13, // 3: entpb.UpdateGroupRequest.name:type_name -> google.protobuf.StringValue
-	12, // 4: entpb.UpdateGroupRequest.metadata:type_name -> google.protobuf.Value
-	12, // 5: entpb.UpdateGroupRequest.tags:type_name -> google.protobuf.Value
-	6,  // 6: entpb.UpdateGroupRequest.users:type_name -> entpb.User
-	14, // 7: entpb.ListGroupRequest.offset:type_name -> google.protobuf.Int32Value
-	14, // 8: entpb.ListGroupRequest.limit:type_name -> google.protobuf.Int32Value
-	13, // 9: entpb.ListGroupRequest.order:type_name -> google.protobuf.StringValue
-	3,  // 10: entpb.ListGroupRequest.filter:type_name -> entpb.ListGroupFilter
-	1,  // 11: entpb.ListGroupResponse.items:type_name -> entpb.Group
-	13, // 12: entpb.User.description:type_name -> google.protobuf.StringValue
-	0,  // 13: entpb.User.gender:type_name -> entpb.User.Gender
-	15, // 14: entpb.User.created_at:type_name -> google.protobuf.Timestamp
-	14, // 15: entpb.User.group_id:type_name -> google.protobuf.Int32Value
-	12, // 16: entpb.User.preferences:type_name -> google.protobuf.Value
-	1,  // 17: entpb.User.group:type_name -> entpb.Group
-	0,  // 18: entpb.UserGenderEnumValue.value:type_name -> entpb.User.Gender
-	13, // 19: entpb.UpdateUserRequest.name:type_name -> google.protobuf.StringValue
-	13, // 20: entpb.UpdateUserRequest.description:type_name -> google.protobuf.StringValue
-	7,  // 21: entpb.UpdateUserRequest.gender:type_name -> entpb.UserGenderEnumValue
-	14, // 22: entpb.UpdateUserRequest.group_id:type_name -> google.protobuf.Int32Value
-	12, // 23: entpb.UpdateUserRequest.preferences:type_name -> google.protobuf.Value
-	1,  // 24: entpb.UpdateUserRequest.group:type_name -> entpb.Group
-	13, // 25: entpb.ListUserFilter.name:type_name -> google.protobuf.StringValue
-	13, // 26: entpb.ListUserFilter.name_contains:type_name -> google.protobuf.StringValue
-	7,  // 27: entpb.ListUserFilter.gender:type_name -> entpb.UserGenderEnumValue
-	0,  // 28: entpb.ListUserFilter.gender_in:type_name -> entpb.User.Gender
-	15, // 29: entpb.ListUserFilter.created_at:type_name -> google.protobuf.Timestamp
-	15, // 30: entpb.ListUserFilter.created_at_in:type_name -> google.protobuf.Timestamp
-	13, // 31: entpb.ListUserFilter.prefix:type_name -> google.protobuf.StringValue
-	14, // 32: entpb.ListUserRequest.offset:type_name -> google.protobuf.Int32Value
-	14, // 33: entpb.ListUserRequest.limit:type_name -> google.protobuf.Int32Value
-	13, // 34: entpb.ListUserRequest.order:type_name -> google.protobuf.StringValue
-	9,  // 35: entpb.ListUserRequest.filter:type_name -> entpb.ListUserFilter
-	6,  // 36: entpb.ListUserResponse.items:type_name -> entpb.User
-	1,  // 37: entpb.GroupService.Create:input_type -> entpb.Group
-	14, // 38: entpb.GroupService.Get:input_type -> google.protobuf.Int32Value
-	2,  // 39: entpb.GroupService.Update:input_type -> entpb.UpdateGroupRequest
-	14, // 40: entpb.GroupService.Delete:input_type -> google.protobuf.Int32Value
-	4,  // 41: entpb.GroupService.List:input_type -> entpb.ListGroupRequest
-	6,  // 42: entpb.UserService.Create:input_type -> entpb.User
-	14, // 43: entpb.UserService.Get:input_type -> google.protobuf.Int32Value
-	8,  // 44: entpb.UserService.Update:input_type -> entpb.UpdateUserRequest
-	14, // 45: entpb.UserService.Delete:input_type -> google.protobuf.Int32Value
-	10, // 46: entpb.UserService.List:input_type -> entpb.ListUserRequest
-	1,  // 47: entpb.GroupService.Create:output_type -> entpb.Group
-	1,  // 48: entpb.GroupService.Get:output_type -> entpb.Group
-	1,  // 49: entpb.GroupService.Update:output_type -> entpb.Group
-	16, // 50: entpb.GroupService.Delete:output_type -> google.protobuf.Empty
-	5,  // 51: entpb.GroupService.List:output_type -> entpb.ListGroupResponse
-	6,  // 52: entpb.UserService.Create:output_type -> entpb.User
-	6,  // 53: entpb.UserService.Get:output_type -> entpb.User
-	6,  // 54: entpb.UserService.Update:output_type -> entpb.User
-	16, // 55: entpb.UserService.Delete:output_type -> google.protobuf.Empty
-	11, // 56: entpb.UserService.List:output_type -> entpb.ListUserResponse
-	47, // [47:57] is the sub-list for method output_type
-	37, // [37:47] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	55,  // 0: entpb.Group.metadata:type_name -> google.protobuf.Value
+	55,  // 1: entpb.Group.tags:type_name -> google.protobuf.Value
+	56,  // 2: entpb.Group.deleted_at:type_name -> google.protobuf.Timestamp
+	32,  // 3: entpb.Group.users:type_name -> entpb.User
+	0,   // 4: entpb.GetGroupRequest.with:type_name -> entpb.GroupEdge
+	57,  // 5: entpb.GetGroupRequest.read_mask:type_name -> google.protobuf.FieldMask
+	58,  // 6: entpb.UpdateGroupRequest.name:type_name -> google.protobuf.StringValue
+	55,  // 7: entpb.UpdateGroupRequest.metadata:type_name -> google.protobuf.Value
+	55,  // 8: entpb.UpdateGroupRequest.tags:type_name -> google.protobuf.Value
+	56,  // 9: entpb.UpdateGroupRequest.deleted_at:type_name -> google.protobuf.Timestamp
+	32,  // 10: entpb.UpdateGroupRequest.users:type_name -> entpb.User
+	57,  // 11: entpb.UpdateGroupRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,   // 12: entpb.ListGroupOrder.field:type_name -> entpb.GroupOrderField
+	59,  // 13: entpb.ListGroupFilter.has_users:type_name -> google.protobuf.BoolValue
+	60,  // 14: entpb.ListGroupFilter.users_id:type_name -> google.protobuf.Int32Value
+	15,  // 15: entpb.ListGroupFilter.and:type_name -> entpb.ListGroupFilter
+	15,  // 16: entpb.ListGroupFilter.or:type_name -> entpb.ListGroupFilter
+	15,  // 17: entpb.ListGroupFilter.not:type_name -> entpb.ListGroupFilter
+	60,  // 18: entpb.ListGroupRequest.offset:type_name -> google.protobuf.Int32Value
+	60,  // 19: entpb.ListGroupRequest.limit:type_name -> google.protobuf.Int32Value
+	15,  // 20: entpb.ListGroupRequest.filter:type_name -> entpb.ListGroupFilter
+	14,  // 21: entpb.ListGroupRequest.order:type_name -> entpb.ListGroupOrder
+	0,   // 22: entpb.ListGroupRequest.with:type_name -> entpb.GroupEdge
+	57,  // 23: entpb.ListGroupRequest.read_mask:type_name -> google.protobuf.FieldMask
+	10,  // 24: entpb.ListGroupResponse.items:type_name -> entpb.Group
+	10,  // 25: entpb.BatchCreateGroupRequest.items:type_name -> entpb.Group
+	10,  // 26: entpb.BatchCreateGroupResponse.items:type_name -> entpb.Group
+	10,  // 27: entpb.BatchGetGroupResponse.items:type_name -> entpb.Group
+	12,  // 28: entpb.BatchUpdateGroupRequest.requests:type_name -> entpb.UpdateGroupRequest
+	10,  // 29: entpb.BatchUpdateGroupResponse.items:type_name -> entpb.Group
+	10,  // 30: entpb.StreamListGroupResponse.items:type_name -> entpb.Group
+	10,  // 31: entpb.UpsertGroupRequest.group:type_name -> entpb.Group
+	2,   // 32: entpb.UpsertGroupRequest.on_conflict:type_name -> entpb.GroupConflictTarget
+	15,  // 33: entpb.WatchGroupRequest.filter:type_name -> entpb.ListGroupFilter
+	3,   // 34: entpb.WatchGroupResponse.type:type_name -> entpb.GroupWatchEventType
+	10,  // 35: entpb.WatchGroupResponse.group:type_name -> entpb.Group
+	58,  // 36: entpb.MergeGroupsRequest.name:type_name -> google.protobuf.StringValue
+	56,  // 37: entpb.MergeGroupsResponse.merged_at:type_name -> google.protobuf.Timestamp
+	58,  // 38: entpb.User.description:type_name -> google.protobuf.StringValue
+	9,   // 39: entpb.User.gender:type_name -> entpb.User.Gender
+	56,  // 40: entpb.User.created_at:type_name -> google.protobuf.Timestamp
+	60,  // 41: entpb.User.group_id:type_name -> google.protobuf.Int32Value
+	55,  // 42: entpb.User.preferences:type_name -> google.protobuf.Value
+	10,  // 43: entpb.User.group:type_name -> entpb.Group
+	9,   // 44: entpb.UserGenderEnumValue.value:type_name -> entpb.User.Gender
+	4,   // 45: entpb.GetUserRequest.with:type_name -> entpb.UserEdge
+	57,  // 46: entpb.GetUserRequest.read_mask:type_name -> google.protobuf.FieldMask
+	58,  // 47: entpb.UpdateUserRequest.name:type_name -> google.protobuf.StringValue
+	58,  // 48: entpb.UpdateUserRequest.description:type_name -> google.protobuf.StringValue
+	33,  // 49: entpb.UpdateUserRequest.gender:type_name -> entpb.UserGenderEnumValue
+	60,  // 50: entpb.UpdateUserRequest.group_id:type_name -> google.protobuf.Int32Value
+	55,  // 51: entpb.UpdateUserRequest.preferences:type_name -> google.protobuf.Value
+	60,  // 52: entpb.UpdateUserRequest.points:type_name -> google.protobuf.Int32Value
+	10,  // 53: entpb.UpdateUserRequest.group:type_name -> entpb.Group
+	57,  // 54: entpb.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	5,   // 55: entpb.ListUserOrder.field:type_name -> entpb.UserOrderField
+	58,  // 56: entpb.ListUserFilter.name:type_name -> google.protobuf.StringValue
+	58,  // 57: entpb.ListUserFilter.name_contains:type_name -> google.protobuf.StringValue
+	58,  // 58: entpb.ListUserFilter.name_has_prefix:type_name -> google.protobuf.StringValue
+	58,  // 59: entpb.ListUserFilter.name_contains_fold:type_name -> google.protobuf.StringValue
+	59,  // 60: entpb.ListUserFilter.description_is_nil:type_name -> google.protobuf.BoolValue
+	33,  // 61: entpb.ListUserFilter.gender:type_name -> entpb.UserGenderEnumValue
+	9,   // 62: entpb.ListUserFilter.gender_in:type_name -> entpb.User.Gender
+	33,  // 63: entpb.ListUserFilter.gender_neq:type_name -> entpb.UserGenderEnumValue
+	9,   // 64: entpb.ListUserFilter.gender_not_in:type_name -> entpb.User.Gender
+	56,  // 65: entpb.ListUserFilter.created_at:type_name -> google.protobuf.Timestamp
+	56,  // 66: entpb.ListUserFilter.created_at_in:type_name -> google.protobuf.Timestamp
+	56,  // 67: entpb.ListUserFilter.created_at_gte:type_name -> google.protobuf.Timestamp
+	56,  // 68: entpb.ListUserFilter.created_at_lte:type_name -> google.protobuf.Timestamp
+	59,  // 69: entpb.ListUserFilter.has_group:type_name -> google.protobuf.BoolValue
+	58,  // 70: entpb.ListUserFilter.prefix:type_name -> google.protobuf.StringValue
+	38,  // 71: entpb.ListUserFilter.and:type_name -> entpb.ListUserFilter
+	38,  // 72: entpb.ListUserFilter.or:type_name -> entpb.ListUserFilter
+	38,  // 73: entpb.ListUserFilter.not:type_name -> entpb.ListUserFilter
+	60,  // 74: entpb.ListUserRequest.offset:type_name -> google.protobuf.Int32Value
+	60,  // 75: entpb.ListUserRequest.limit:type_name -> google.protobuf.Int32Value
+	38,  // 76: entpb.ListUserRequest.filter:type_name -> entpb.ListUserFilter
+	37,  // 77: entpb.ListUserRequest.order:type_name -> entpb.ListUserOrder
+	4,   // 78: entpb.ListUserRequest.with:type_name -> entpb.UserEdge
+	57,  // 79: entpb.ListUserRequest.read_mask:type_name -> google.protobuf.FieldMask
+	32,  // 80: entpb.ListUserResponse.items:type_name -> entpb.User
+	32,  // 81: entpb.BatchCreateUserRequest.items:type_name -> entpb.User
+	32,  // 82: entpb.BatchCreateUserResponse.items:type_name -> entpb.User
+	32,  // 83: entpb.BatchGetUserResponse.items:type_name -> entpb.User
+	35,  // 84: entpb.BatchUpdateUserRequest.requests:type_name -> entpb.UpdateUserRequest
+	32,  // 85: entpb.BatchUpdateUserResponse.items:type_name -> entpb.User
+	32,  // 86: entpb.StreamListUserResponse.items:type_name -> entpb.User
+	38,  // 87: entpb.CountUserRequest.filter:type_name -> entpb.ListUserFilter
+	8,   // 88: entpb.AggregateUserMetric.function:type_name -> entpb.UserAggregateFunction
+	7,   // 89: entpb.AggregateUserMetric.field:type_name -> entpb.UserAggregateField
+	38,  // 90: entpb.AggregateUserRequest.filter:type_name -> entpb.ListUserFilter
+	6,   // 91: entpb.AggregateUserRequest.group_by:type_name -> entpb.UserGroupField
+	51,  // 92: entpb.AggregateUserRequest.metrics:type_name -> entpb.AggregateUserMetric
+	32,  // 93: entpb.AggregateUserGroup.key:type_name -> entpb.User
+	55,  // 94: entpb.AggregateUserGroup.values:type_name -> google.protobuf.Value
+	53,  // 95: entpb.AggregateUserResponse.groups:type_name -> entpb.AggregateUserGroup
+	10,  // 96: entpb.GroupService.Create:input_type -> entpb.Group
+	11,  // 97: entpb.GroupService.Get:input_type -> entpb.GetGroupRequest
+	12,  // 98: entpb.GroupService.Update:input_type -> entpb.UpdateGroupRequest
+	13,  // 99: entpb.GroupService.Delete:input_type -> entpb.DeleteGroupRequest
+	16,  // 100: entpb.GroupService.List:input_type -> entpb.ListGroupRequest
+	18,  // 101: entpb.GroupService.BatchCreate:input_type -> entpb.BatchCreateGroupRequest
+	20,  // 102: entpb.GroupService.BatchGet:input_type -> entpb.BatchGetGroupRequest
+	22,  // 103: entpb.GroupService.BatchUpdate:input_type -> entpb.BatchUpdateGroupRequest
+	24,  // 104: entpb.GroupService.BatchDelete:input_type -> entpb.BatchDeleteGroupRequest
+	16,  // 105: entpb.GroupService.StreamList:input_type -> entpb.ListGroupRequest
+	26,  // 106: entpb.GroupService.Upsert:input_type -> entpb.UpsertGroupRequest
+	27,  // 107: entpb.GroupService.Watch:input_type -> entpb.WatchGroupRequest
+	29,  // 108: entpb.GroupService.Undelete:input_type -> entpb.UndeleteGroupRequest
+	30,  // 109: entpb.GroupService.Merge:input_type -> entpb.MergeGroupsRequest
+	32,  // 110: entpb.UserService.Create:input_type -> entpb.User
+	34,  // 111: entpb.UserService.Get:input_type -> entpb.GetUserRequest
+	35,  // 112: entpb.UserService.Update:input_type -> entpb.UpdateUserRequest
+	36,  // 113: entpb.UserService.Delete:input_type -> entpb.DeleteUserRequest
+	39,  // 114: entpb.UserService.List:input_type -> entpb.ListUserRequest
+	41,  // 115: entpb.UserService.BatchCreate:input_type -> entpb.BatchCreateUserRequest
+	43,  // 116: entpb.UserService.BatchGet:input_type -> entpb.BatchGetUserRequest
+	45,  // 117: entpb.UserService.BatchUpdate:input_type -> entpb.BatchUpdateUserRequest
+	47,  // 118: entpb.UserService.BatchDelete:input_type -> entpb.BatchDeleteUserRequest
+	39,  // 119: entpb.UserService.StreamList:input_type -> entpb.ListUserRequest
+	49,  // 120: entpb.UserService.Count:input_type -> entpb.CountUserRequest
+	52,  // 121: entpb.UserService.Aggregate:input_type -> entpb.AggregateUserRequest
+	10,  // 122: entpb.GroupService.Create:output_type -> entpb.Group
+	10,  // 123: entpb.GroupService.Get:output_type -> entpb.Group
+	10,  // 124: entpb.GroupService.Update:output_type -> entpb.Group
+	61,  // 125: entpb.GroupService.Delete:output_type -> google.protobuf.Empty
+	17,  // 126: entpb.GroupService.List:output_type -> entpb.ListGroupResponse
+	19,  // 127: entpb.GroupService.BatchCreate:output_type -> entpb.BatchCreateGroupResponse
+	21,  // 128: entpb.GroupService.BatchGet:output_type -> entpb.BatchGetGroupResponse
+	23,  // 129: entpb.GroupService.BatchUpdate:output_type -> entpb.BatchUpdateGroupResponse
+	61,  // 130: entpb.GroupService.BatchDelete:output_type -> google.protobuf.Empty
+	25,  // 131: entpb.GroupService.StreamList:output_type -> entpb.StreamListGroupResponse
+	10,  // 132: entpb.GroupService.Upsert:output_type -> entpb.Group
+	28,  // 133: entpb.GroupService.Watch:output_type -> entpb.WatchGroupResponse
+	10,  // 134: entpb.GroupService.Undelete:output_type -> entpb.Group
+	31,  // 135: entpb.GroupService.Merge:output_type -> entpb.MergeGroupsResponse
+	32,  // 136: entpb.UserService.Create:output_type -> entpb.User
+	32,  // 137: entpb.UserService.Get:output_type -> entpb.User
+	32,  // 138: entpb.UserService.Update:output_type -> entpb.User
+	61,  // 139: entpb.UserService.Delete:output_type -> google.protobuf.Empty
+	40,  // 140: entpb.UserService.List:output_type -> entpb.ListUserResponse
+	42,  // 141: entpb.UserService.BatchCreate:output_type -> entpb.BatchCreateUserResponse
+	44,  // 142: entpb.UserService.BatchGet:output_type -> entpb.BatchGetUserResponse
+	46,  // 143: entpb.UserService.BatchUpdate:output_type -> entpb.BatchUpdateUserResponse
+	61,  // 144: entpb.UserService.BatchDelete:output_type -> google.protobuf.Empty
+	48,  // 145: entpb.UserService.StreamList:output_type -> entpb.StreamListUserResponse
+	50,  // 146: entpb.UserService.Count:output_type -> entpb.CountUserResponse
+	54,  // 147: entpb.UserService.Aggregate:output_type -> entpb.AggregateUserResponse
+	122, // [122:148] is the sub-list for method output_type
+	96,  // [96:122] is the sub-list for method input_type
+	96,  // [96:96] is the sub-list for extension type_name
+	96,  // [96:96] is the sub-list for extension extendee
+	0,   // [0:96] is the sub-list for field type_name
 }
 
 func init() { file_proto_entpb_entpb_proto_init() }
@@ -1230,8 +4240,128 @@ func file_proto_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_proto_entpb_entpb_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateGroupRequest); i {
+		file_proto_entpb_entpb_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ListGroupOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ListGroupFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ListGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ListGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchCreateGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchCreateGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetGroupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1242,8 +4372,8 @@ func file_proto_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_proto_entpb_entpb_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*ListGroupFilter); i {
+		file_proto_entpb_entpb_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchUpdateGroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1254,8 +4384,8 @@ func file_proto_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_proto_entpb_entpb_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*ListGroupRequest); i {
+		file_proto_entpb_entpb_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchUpdateGroupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1266,8 +4396,8 @@ func file_proto_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_proto_entpb_entpb_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*ListGroupResponse); i {
+		file_proto_entpb_entpb_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchDeleteGroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1278,7 +4408,91 @@ func file_proto_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_proto_entpb_entpb_proto_msgTypes[5].Exporter = func(v any, i int) any {
+		file_proto_entpb_entpb_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamListGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*UpsertGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*UndeleteGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*MergeGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*MergeGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[22].Exporter = func(v any, i int) any {
 			switch v := v.(*User); i {
 			case 0:
 				return &v.state
@@ -1290,7 +4504,7 @@ func file_proto_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_proto_entpb_entpb_proto_msgTypes[6].Exporter = func(v any, i int) any {
+		file_proto_entpb_entpb_proto_msgTypes[23].Exporter = func(v any, i int) any {
 			switch v := v.(*UserGenderEnumValue); i {
 			case 0:
 				return &v.state
@@ -1302,7 +4516,19 @@ func file_proto_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_proto_entpb_entpb_proto_msgTypes[7].Exporter = func(v any, i int) any {
+		file_proto_entpb_entpb_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[25].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateUserRequest); i {
 			case 0:
 				return &v.state
@@ -1314,7 +4540,31 @@ func file_proto_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_proto_entpb_entpb_proto_msgTypes[8].Exporter = func(v any, i int) any {
+		file_proto_entpb_entpb_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ListUserOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[28].Exporter = func(v any, i int) any {
 			switch v := v.(*ListUserFilter); i {
 			case 0:
 				return &v.state
@@ -1326,7 +4576,7 @@ func file_proto_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_proto_entpb_entpb_proto_msgTypes[9].Exporter = func(v any, i int) any {
+		file_proto_entpb_entpb_proto_msgTypes[29].Exporter = func(v any, i int) any {
 			switch v := v.(*ListUserRequest); i {
 			case 0:
 				return &v.state
@@ -1338,7 +4588,7 @@ func file_proto_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
-		file_proto_entpb_entpb_proto_msgTypes[10].Exporter = func(v any, i int) any {
+		file_proto_entpb_entpb_proto_msgTypes[30].Exporter = func(v any, i int) any {
 			switch v := v.(*ListUserResponse); i {
 			case 0:
 				return &v.state
@@ -1350,14 +4600,182 @@ func file_proto_entpb_entpb_proto_init() {
 				return nil
 			}
 		}
+		file_proto_entpb_entpb_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchCreateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchCreateUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchGetUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchUpdateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchUpdateUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchDeleteUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamListUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*CountUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*CountUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*AggregateUserMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*AggregateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*AggregateUserGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_entpb_entpb_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*AggregateUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_entpb_entpb_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      10,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
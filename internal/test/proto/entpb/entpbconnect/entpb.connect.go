@@ -12,7 +12,6 @@ import (
 	errors "errors"
 	entpb "github.com/yoshino-s/entproto/internal/test/proto/entpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
-	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	http "net/http"
 	strings "strings"
 )
@@ -49,6 +48,27 @@ const (
 	GroupServiceDeleteProcedure = "/entpb.GroupService/Delete"
 	// GroupServiceListProcedure is the fully-qualified name of the GroupService's List RPC.
 	GroupServiceListProcedure = "/entpb.GroupService/List"
+	// GroupServiceBatchCreateProcedure is the fully-qualified name of the GroupService's BatchCreate
+	// RPC.
+	GroupServiceBatchCreateProcedure = "/entpb.GroupService/BatchCreate"
+	// GroupServiceBatchGetProcedure is the fully-qualified name of the GroupService's BatchGet RPC.
+	GroupServiceBatchGetProcedure = "/entpb.GroupService/BatchGet"
+	// GroupServiceBatchUpdateProcedure is the fully-qualified name of the GroupService's BatchUpdate
+	// RPC.
+	GroupServiceBatchUpdateProcedure = "/entpb.GroupService/BatchUpdate"
+	// GroupServiceBatchDeleteProcedure is the fully-qualified name of the GroupService's BatchDelete
+	// RPC.
+	GroupServiceBatchDeleteProcedure = "/entpb.GroupService/BatchDelete"
+	// GroupServiceStreamListProcedure is the fully-qualified name of the GroupService's StreamList RPC.
+	GroupServiceStreamListProcedure = "/entpb.GroupService/StreamList"
+	// GroupServiceUpsertProcedure is the fully-qualified name of the GroupService's Upsert RPC.
+	GroupServiceUpsertProcedure = "/entpb.GroupService/Upsert"
+	// GroupServiceWatchProcedure is the fully-qualified name of the GroupService's Watch RPC.
+	GroupServiceWatchProcedure = "/entpb.GroupService/Watch"
+	// GroupServiceUndeleteProcedure is the fully-qualified name of the GroupService's Undelete RPC.
+	GroupServiceUndeleteProcedure = "/entpb.GroupService/Undelete"
+	// GroupServiceMergeProcedure is the fully-qualified name of the GroupService's Merge RPC.
+	GroupServiceMergeProcedure = "/entpb.GroupService/Merge"
 	// UserServiceCreateProcedure is the fully-qualified name of the UserService's Create RPC.
 	UserServiceCreateProcedure = "/entpb.UserService/Create"
 	// UserServiceGetProcedure is the fully-qualified name of the UserService's Get RPC.
@@ -59,31 +79,70 @@ const (
 	UserServiceDeleteProcedure = "/entpb.UserService/Delete"
 	// UserServiceListProcedure is the fully-qualified name of the UserService's List RPC.
 	UserServiceListProcedure = "/entpb.UserService/List"
+	// UserServiceBatchCreateProcedure is the fully-qualified name of the UserService's BatchCreate RPC.
+	UserServiceBatchCreateProcedure = "/entpb.UserService/BatchCreate"
+	// UserServiceBatchGetProcedure is the fully-qualified name of the UserService's BatchGet RPC.
+	UserServiceBatchGetProcedure = "/entpb.UserService/BatchGet"
+	// UserServiceBatchUpdateProcedure is the fully-qualified name of the UserService's BatchUpdate RPC.
+	UserServiceBatchUpdateProcedure = "/entpb.UserService/BatchUpdate"
+	// UserServiceBatchDeleteProcedure is the fully-qualified name of the UserService's BatchDelete RPC.
+	UserServiceBatchDeleteProcedure = "/entpb.UserService/BatchDelete"
+	// UserServiceStreamListProcedure is the fully-qualified name of the UserService's StreamList RPC.
+	UserServiceStreamListProcedure = "/entpb.UserService/StreamList"
+	// UserServiceCountProcedure is the fully-qualified name of the UserService's Count RPC.
+	UserServiceCountProcedure = "/entpb.UserService/Count"
+	// UserServiceAggregateProcedure is the fully-qualified name of the UserService's Aggregate RPC.
+	UserServiceAggregateProcedure = "/entpb.UserService/Aggregate"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	groupServiceServiceDescriptor      = entpb.File_proto_entpb_entpb_proto.Services().ByName("GroupService")
-	groupServiceCreateMethodDescriptor = groupServiceServiceDescriptor.Methods().ByName("Create")
-	groupServiceGetMethodDescriptor    = groupServiceServiceDescriptor.Methods().ByName("Get")
-	groupServiceUpdateMethodDescriptor = groupServiceServiceDescriptor.Methods().ByName("Update")
-	groupServiceDeleteMethodDescriptor = groupServiceServiceDescriptor.Methods().ByName("Delete")
-	groupServiceListMethodDescriptor   = groupServiceServiceDescriptor.Methods().ByName("List")
-	userServiceServiceDescriptor       = entpb.File_proto_entpb_entpb_proto.Services().ByName("UserService")
-	userServiceCreateMethodDescriptor  = userServiceServiceDescriptor.Methods().ByName("Create")
-	userServiceGetMethodDescriptor     = userServiceServiceDescriptor.Methods().ByName("Get")
-	userServiceUpdateMethodDescriptor  = userServiceServiceDescriptor.Methods().ByName("Update")
-	userServiceDeleteMethodDescriptor  = userServiceServiceDescriptor.Methods().ByName("Delete")
-	userServiceListMethodDescriptor    = userServiceServiceDescriptor.Methods().ByName("List")
+	groupServiceServiceDescriptor           = entpb.File_proto_entpb_entpb_proto.Services().ByName("GroupService")
+	groupServiceCreateMethodDescriptor      = groupServiceServiceDescriptor.Methods().ByName("Create")
+	groupServiceGetMethodDescriptor         = groupServiceServiceDescriptor.Methods().ByName("Get")
+	groupServiceUpdateMethodDescriptor      = groupServiceServiceDescriptor.Methods().ByName("Update")
+	groupServiceDeleteMethodDescriptor      = groupServiceServiceDescriptor.Methods().ByName("Delete")
+	groupServiceListMethodDescriptor        = groupServiceServiceDescriptor.Methods().ByName("List")
+	groupServiceBatchCreateMethodDescriptor = groupServiceServiceDescriptor.Methods().ByName("BatchCreate")
+	groupServiceBatchGetMethodDescriptor    = groupServiceServiceDescriptor.Methods().ByName("BatchGet")
+	groupServiceBatchUpdateMethodDescriptor = groupServiceServiceDescriptor.Methods().ByName("BatchUpdate")
+	groupServiceBatchDeleteMethodDescriptor = groupServiceServiceDescriptor.Methods().ByName("BatchDelete")
+	groupServiceStreamListMethodDescriptor  = groupServiceServiceDescriptor.Methods().ByName("StreamList")
+	groupServiceUpsertMethodDescriptor      = groupServiceServiceDescriptor.Methods().ByName("Upsert")
+	groupServiceWatchMethodDescriptor       = groupServiceServiceDescriptor.Methods().ByName("Watch")
+	groupServiceUndeleteMethodDescriptor    = groupServiceServiceDescriptor.Methods().ByName("Undelete")
+	groupServiceMergeMethodDescriptor       = groupServiceServiceDescriptor.Methods().ByName("Merge")
+	userServiceServiceDescriptor            = entpb.File_proto_entpb_entpb_proto.Services().ByName("UserService")
+	userServiceCreateMethodDescriptor       = userServiceServiceDescriptor.Methods().ByName("Create")
+	userServiceGetMethodDescriptor          = userServiceServiceDescriptor.Methods().ByName("Get")
+	userServiceUpdateMethodDescriptor       = userServiceServiceDescriptor.Methods().ByName("Update")
+	userServiceDeleteMethodDescriptor       = userServiceServiceDescriptor.Methods().ByName("Delete")
+	userServiceListMethodDescriptor         = userServiceServiceDescriptor.Methods().ByName("List")
+	userServiceBatchCreateMethodDescriptor  = userServiceServiceDescriptor.Methods().ByName("BatchCreate")
+	userServiceBatchGetMethodDescriptor     = userServiceServiceDescriptor.Methods().ByName("BatchGet")
+	userServiceBatchUpdateMethodDescriptor  = userServiceServiceDescriptor.Methods().ByName("BatchUpdate")
+	userServiceBatchDeleteMethodDescriptor  = userServiceServiceDescriptor.Methods().ByName("BatchDelete")
+	userServiceStreamListMethodDescriptor   = userServiceServiceDescriptor.Methods().ByName("StreamList")
+	userServiceCountMethodDescriptor        = userServiceServiceDescriptor.Methods().ByName("Count")
+	userServiceAggregateMethodDescriptor    = userServiceServiceDescriptor.Methods().ByName("Aggregate")
 )
 
 // GroupServiceClient is a client for the entpb.GroupService service.
 type GroupServiceClient interface {
 	Create(context.Context, *connect.Request[entpb.Group]) (*connect.Response[entpb.Group], error)
-	Get(context.Context, *connect.Request[wrapperspb.Int32Value]) (*connect.Response[entpb.Group], error)
+	Get(context.Context, *connect.Request[entpb.GetGroupRequest]) (*connect.Response[entpb.Group], error)
 	Update(context.Context, *connect.Request[entpb.UpdateGroupRequest]) (*connect.Response[entpb.Group], error)
-	Delete(context.Context, *connect.Request[wrapperspb.Int32Value]) (*connect.Response[emptypb.Empty], error)
+	Delete(context.Context, *connect.Request[entpb.DeleteGroupRequest]) (*connect.Response[emptypb.Empty], error)
 	List(context.Context, *connect.Request[entpb.ListGroupRequest]) (*connect.Response[entpb.ListGroupResponse], error)
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreateGroupRequest]) (*connect.Response[entpb.BatchCreateGroupResponse], error)
+	BatchGet(context.Context, *connect.Request[entpb.BatchGetGroupRequest]) (*connect.Response[entpb.BatchGetGroupResponse], error)
+	BatchUpdate(context.Context, *connect.Request[entpb.BatchUpdateGroupRequest]) (*connect.Response[entpb.BatchUpdateGroupResponse], error)
+	BatchDelete(context.Context, *connect.Request[entpb.BatchDeleteGroupRequest]) (*connect.Response[emptypb.Empty], error)
+	StreamList(context.Context, *connect.Request[entpb.ListGroupRequest]) (*connect.ServerStreamForClient[entpb.StreamListGroupResponse], error)
+	Upsert(context.Context, *connect.Request[entpb.UpsertGroupRequest]) (*connect.Response[entpb.Group], error)
+	Watch(context.Context, *connect.Request[entpb.WatchGroupRequest]) (*connect.ServerStreamForClient[entpb.WatchGroupResponse], error)
+	Undelete(context.Context, *connect.Request[entpb.UndeleteGroupRequest]) (*connect.Response[entpb.Group], error)
+	Merge(context.Context, *connect.Request[entpb.MergeGroupsRequest]) (*connect.Response[entpb.MergeGroupsResponse], error)
 }
 
 // NewGroupServiceClient constructs a client for the entpb.GroupService service. By default, it uses
@@ -102,7 +161,7 @@ func NewGroupServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(groupServiceCreateMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
-		get: connect.NewClient[wrapperspb.Int32Value, entpb.Group](
+		get: connect.NewClient[entpb.GetGroupRequest, entpb.Group](
 			httpClient,
 			baseURL+GroupServiceGetProcedure,
 			connect.WithSchema(groupServiceGetMethodDescriptor),
@@ -115,7 +174,7 @@ func NewGroupServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(groupServiceUpdateMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
-		delete: connect.NewClient[wrapperspb.Int32Value, emptypb.Empty](
+		delete: connect.NewClient[entpb.DeleteGroupRequest, emptypb.Empty](
 			httpClient,
 			baseURL+GroupServiceDeleteProcedure,
 			connect.WithSchema(groupServiceDeleteMethodDescriptor),
@@ -128,16 +187,82 @@ func NewGroupServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		batchCreate: connect.NewClient[entpb.BatchCreateGroupRequest, entpb.BatchCreateGroupResponse](
+			httpClient,
+			baseURL+GroupServiceBatchCreateProcedure,
+			connect.WithSchema(groupServiceBatchCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		batchGet: connect.NewClient[entpb.BatchGetGroupRequest, entpb.BatchGetGroupResponse](
+			httpClient,
+			baseURL+GroupServiceBatchGetProcedure,
+			connect.WithSchema(groupServiceBatchGetMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		batchUpdate: connect.NewClient[entpb.BatchUpdateGroupRequest, entpb.BatchUpdateGroupResponse](
+			httpClient,
+			baseURL+GroupServiceBatchUpdateProcedure,
+			connect.WithSchema(groupServiceBatchUpdateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		batchDelete: connect.NewClient[entpb.BatchDeleteGroupRequest, emptypb.Empty](
+			httpClient,
+			baseURL+GroupServiceBatchDeleteProcedure,
+			connect.WithSchema(groupServiceBatchDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		streamList: connect.NewClient[entpb.ListGroupRequest, entpb.StreamListGroupResponse](
+			httpClient,
+			baseURL+GroupServiceStreamListProcedure,
+			connect.WithSchema(groupServiceStreamListMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		upsert: connect.NewClient[entpb.UpsertGroupRequest, entpb.Group](
+			httpClient,
+			baseURL+GroupServiceUpsertProcedure,
+			connect.WithSchema(groupServiceUpsertMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		watch: connect.NewClient[entpb.WatchGroupRequest, entpb.WatchGroupResponse](
+			httpClient,
+			baseURL+GroupServiceWatchProcedure,
+			connect.WithSchema(groupServiceWatchMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		undelete: connect.NewClient[entpb.UndeleteGroupRequest, entpb.Group](
+			httpClient,
+			baseURL+GroupServiceUndeleteProcedure,
+			connect.WithSchema(groupServiceUndeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		merge: connect.NewClient[entpb.MergeGroupsRequest, entpb.MergeGroupsResponse](
+			httpClient,
+			baseURL+GroupServiceMergeProcedure,
+			connect.WithSchema(groupServiceMergeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // groupServiceClient implements GroupServiceClient.
 type groupServiceClient struct {
-	create *connect.Client[entpb.Group, entpb.Group]
-	get    *connect.Client[wrapperspb.Int32Value, entpb.Group]
-	update *connect.Client[entpb.UpdateGroupRequest, entpb.Group]
-	delete *connect.Client[wrapperspb.Int32Value, emptypb.Empty]
-	list   *connect.Client[entpb.ListGroupRequest, entpb.ListGroupResponse]
+	create      *connect.Client[entpb.Group, entpb.Group]
+	get         *connect.Client[entpb.GetGroupRequest, entpb.Group]
+	update      *connect.Client[entpb.UpdateGroupRequest, entpb.Group]
+	delete      *connect.Client[entpb.DeleteGroupRequest, emptypb.Empty]
+	list        *connect.Client[entpb.ListGroupRequest, entpb.ListGroupResponse]
+	batchCreate *connect.Client[entpb.BatchCreateGroupRequest, entpb.BatchCreateGroupResponse]
+	batchGet    *connect.Client[entpb.BatchGetGroupRequest, entpb.BatchGetGroupResponse]
+	batchUpdate *connect.Client[entpb.BatchUpdateGroupRequest, entpb.BatchUpdateGroupResponse]
+	batchDelete *connect.Client[entpb.BatchDeleteGroupRequest, emptypb.Empty]
+	streamList  *connect.Client[entpb.ListGroupRequest, entpb.StreamListGroupResponse]
+	upsert      *connect.Client[entpb.UpsertGroupRequest, entpb.Group]
+	watch       *connect.Client[entpb.WatchGroupRequest, entpb.WatchGroupResponse]
+	undelete    *connect.Client[entpb.UndeleteGroupRequest, entpb.Group]
+	merge       *connect.Client[entpb.MergeGroupsRequest, entpb.MergeGroupsResponse]
 }
 
 // Create calls entpb.GroupService.Create.
@@ -146,7 +271,7 @@ func (c *groupServiceClient) Create(ctx context.Context, req *connect.Request[en
 }
 
 // Get calls entpb.GroupService.Get.
-func (c *groupServiceClient) Get(ctx context.Context, req *connect.Request[wrapperspb.Int32Value]) (*connect.Response[entpb.Group], error) {
+func (c *groupServiceClient) Get(ctx context.Context, req *connect.Request[entpb.GetGroupRequest]) (*connect.Response[entpb.Group], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
@@ -156,7 +281,7 @@ func (c *groupServiceClient) Update(ctx context.Context, req *connect.Request[en
 }
 
 // Delete calls entpb.GroupService.Delete.
-func (c *groupServiceClient) Delete(ctx context.Context, req *connect.Request[wrapperspb.Int32Value]) (*connect.Response[emptypb.Empty], error) {
+func (c *groupServiceClient) Delete(ctx context.Context, req *connect.Request[entpb.DeleteGroupRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
@@ -165,13 +290,67 @@ func (c *groupServiceClient) List(ctx context.Context, req *connect.Request[entp
 	return c.list.CallUnary(ctx, req)
 }
 
+// BatchCreate calls entpb.GroupService.BatchCreate.
+func (c *groupServiceClient) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreateGroupRequest]) (*connect.Response[entpb.BatchCreateGroupResponse], error) {
+	return c.batchCreate.CallUnary(ctx, req)
+}
+
+// BatchGet calls entpb.GroupService.BatchGet.
+func (c *groupServiceClient) BatchGet(ctx context.Context, req *connect.Request[entpb.BatchGetGroupRequest]) (*connect.Response[entpb.BatchGetGroupResponse], error) {
+	return c.batchGet.CallUnary(ctx, req)
+}
+
+// BatchUpdate calls entpb.GroupService.BatchUpdate.
+func (c *groupServiceClient) BatchUpdate(ctx context.Context, req *connect.Request[entpb.BatchUpdateGroupRequest]) (*connect.Response[entpb.BatchUpdateGroupResponse], error) {
+	return c.batchUpdate.CallUnary(ctx, req)
+}
+
+// BatchDelete calls entpb.GroupService.BatchDelete.
+func (c *groupServiceClient) BatchDelete(ctx context.Context, req *connect.Request[entpb.BatchDeleteGroupRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.batchDelete.CallUnary(ctx, req)
+}
+
+// StreamList calls entpb.GroupService.StreamList.
+func (c *groupServiceClient) StreamList(ctx context.Context, req *connect.Request[entpb.ListGroupRequest]) (*connect.ServerStreamForClient[entpb.StreamListGroupResponse], error) {
+	return c.streamList.CallServerStream(ctx, req)
+}
+
+// Upsert calls entpb.GroupService.Upsert.
+func (c *groupServiceClient) Upsert(ctx context.Context, req *connect.Request[entpb.UpsertGroupRequest]) (*connect.Response[entpb.Group], error) {
+	return c.upsert.CallUnary(ctx, req)
+}
+
+// Watch calls entpb.GroupService.Watch.
+func (c *groupServiceClient) Watch(ctx context.Context, req *connect.Request[entpb.WatchGroupRequest]) (*connect.ServerStreamForClient[entpb.WatchGroupResponse], error) {
+	return c.watch.CallServerStream(ctx, req)
+}
+
+// Undelete calls entpb.GroupService.Undelete.
+func (c *groupServiceClient) Undelete(ctx context.Context, req *connect.Request[entpb.UndeleteGroupRequest]) (*connect.Response[entpb.Group], error) {
+	return c.undelete.CallUnary(ctx, req)
+}
+
+// Merge calls entpb.GroupService.Merge.
+func (c *groupServiceClient) Merge(ctx context.Context, req *connect.Request[entpb.MergeGroupsRequest]) (*connect.Response[entpb.MergeGroupsResponse], error) {
+	return c.merge.CallUnary(ctx, req)
+}
+
 // GroupServiceHandler is an implementation of the entpb.GroupService service.
 type GroupServiceHandler interface {
 	Create(context.Context, *connect.Request[entpb.Group]) (*connect.Response[entpb.Group], error)
-	Get(context.Context, *connect.Request[wrapperspb.Int32Value]) (*connect.Response[entpb.Group], error)
+	Get(context.Context, *connect.Request[entpb.GetGroupRequest]) (*connect.Response[entpb.Group], error)
 	Update(context.Context, *connect.Request[entpb.UpdateGroupRequest]) (*connect.Response[entpb.Group], error)
-	Delete(context.Context, *connect.Request[wrapperspb.Int32Value]) (*connect.Response[emptypb.Empty], error)
+	Delete(context.Context, *connect.Request[entpb.DeleteGroupRequest]) (*connect.Response[emptypb.Empty], error)
 	List(context.Context, *connect.Request[entpb.ListGroupRequest]) (*connect.Response[entpb.ListGroupResponse], error)
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreateGroupRequest]) (*connect.Response[entpb.BatchCreateGroupResponse], error)
+	BatchGet(context.Context, *connect.Request[entpb.BatchGetGroupRequest]) (*connect.Response[entpb.BatchGetGroupResponse], error)
+	BatchUpdate(context.Context, *connect.Request[entpb.BatchUpdateGroupRequest]) (*connect.Response[entpb.BatchUpdateGroupResponse], error)
+	BatchDelete(context.Context, *connect.Request[entpb.BatchDeleteGroupRequest]) (*connect.Response[emptypb.Empty], error)
+	StreamList(context.Context, *connect.Request[entpb.ListGroupRequest], *connect.ServerStream[entpb.StreamListGroupResponse]) error
+	Upsert(context.Context, *connect.Request[entpb.UpsertGroupRequest]) (*connect.Response[entpb.Group], error)
+	Watch(context.Context, *connect.Request[entpb.WatchGroupRequest], *connect.ServerStream[entpb.WatchGroupResponse]) error
+	Undelete(context.Context, *connect.Request[entpb.UndeleteGroupRequest]) (*connect.Response[entpb.Group], error)
+	Merge(context.Context, *connect.Request[entpb.MergeGroupsRequest]) (*connect.Response[entpb.MergeGroupsResponse], error)
 }
 
 // NewGroupServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -212,6 +391,63 @@ func NewGroupServiceHandler(svc GroupServiceHandler, opts ...connect.HandlerOpti
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	groupServiceBatchCreateHandler := connect.NewUnaryHandler(
+		GroupServiceBatchCreateProcedure,
+		svc.BatchCreate,
+		connect.WithSchema(groupServiceBatchCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	groupServiceBatchGetHandler := connect.NewUnaryHandler(
+		GroupServiceBatchGetProcedure,
+		svc.BatchGet,
+		connect.WithSchema(groupServiceBatchGetMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	groupServiceBatchUpdateHandler := connect.NewUnaryHandler(
+		GroupServiceBatchUpdateProcedure,
+		svc.BatchUpdate,
+		connect.WithSchema(groupServiceBatchUpdateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	groupServiceBatchDeleteHandler := connect.NewUnaryHandler(
+		GroupServiceBatchDeleteProcedure,
+		svc.BatchDelete,
+		connect.WithSchema(groupServiceBatchDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	groupServiceStreamListHandler := connect.NewServerStreamHandler(
+		GroupServiceStreamListProcedure,
+		svc.StreamList,
+		connect.WithSchema(groupServiceStreamListMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	groupServiceUpsertHandler := connect.NewUnaryHandler(
+		GroupServiceUpsertProcedure,
+		svc.Upsert,
+		connect.WithSchema(groupServiceUpsertMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	groupServiceWatchHandler := connect.NewServerStreamHandler(
+		GroupServiceWatchProcedure,
+		svc.Watch,
+		connect.WithSchema(groupServiceWatchMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	groupServiceUndeleteHandler := connect.NewUnaryHandler(
+		GroupServiceUndeleteProcedure,
+		svc.Undelete,
+		connect.WithSchema(groupServiceUndeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	groupServiceMergeHandler := connect.NewUnaryHandler(
+		GroupServiceMergeProcedure,
+		svc.Merge,
+		connect.WithSchema(groupServiceMergeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/entpb.GroupService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GroupServiceCreateProcedure:
@@ -224,6 +460,24 @@ func NewGroupServiceHandler(svc GroupServiceHandler, opts ...connect.HandlerOpti
 			groupServiceDeleteHandler.ServeHTTP(w, r)
 		case GroupServiceListProcedure:
 			groupServiceListHandler.ServeHTTP(w, r)
+		case GroupServiceBatchCreateProcedure:
+			groupServiceBatchCreateHandler.ServeHTTP(w, r)
+		case GroupServiceBatchGetProcedure:
+			groupServiceBatchGetHandler.ServeHTTP(w, r)
+		case GroupServiceBatchUpdateProcedure:
+			groupServiceBatchUpdateHandler.ServeHTTP(w, r)
+		case GroupServiceBatchDeleteProcedure:
+			groupServiceBatchDeleteHandler.ServeHTTP(w, r)
+		case GroupServiceStreamListProcedure:
+			groupServiceStreamListHandler.ServeHTTP(w, r)
+		case GroupServiceUpsertProcedure:
+			groupServiceUpsertHandler.ServeHTTP(w, r)
+		case GroupServiceWatchProcedure:
+			groupServiceWatchHandler.ServeHTTP(w, r)
+		case GroupServiceUndeleteProcedure:
+			groupServiceUndeleteHandler.ServeHTTP(w, r)
+		case GroupServiceMergeProcedure:
+			groupServiceMergeHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -237,7 +491,7 @@ func (UnimplementedGroupServiceHandler) Create(context.Context, *connect.Request
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.GroupService.Create is not implemented"))
 }
 
-func (UnimplementedGroupServiceHandler) Get(context.Context, *connect.Request[wrapperspb.Int32Value]) (*connect.Response[entpb.Group], error) {
+func (UnimplementedGroupServiceHandler) Get(context.Context, *connect.Request[entpb.GetGroupRequest]) (*connect.Response[entpb.Group], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.GroupService.Get is not implemented"))
 }
 
@@ -245,7 +499,7 @@ func (UnimplementedGroupServiceHandler) Update(context.Context, *connect.Request
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.GroupService.Update is not implemented"))
 }
 
-func (UnimplementedGroupServiceHandler) Delete(context.Context, *connect.Request[wrapperspb.Int32Value]) (*connect.Response[emptypb.Empty], error) {
+func (UnimplementedGroupServiceHandler) Delete(context.Context, *connect.Request[entpb.DeleteGroupRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.GroupService.Delete is not implemented"))
 }
 
@@ -253,13 +507,56 @@ func (UnimplementedGroupServiceHandler) List(context.Context, *connect.Request[e
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.GroupService.List is not implemented"))
 }
 
+func (UnimplementedGroupServiceHandler) BatchCreate(context.Context, *connect.Request[entpb.BatchCreateGroupRequest]) (*connect.Response[entpb.BatchCreateGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.GroupService.BatchCreate is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) BatchGet(context.Context, *connect.Request[entpb.BatchGetGroupRequest]) (*connect.Response[entpb.BatchGetGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.GroupService.BatchGet is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) BatchUpdate(context.Context, *connect.Request[entpb.BatchUpdateGroupRequest]) (*connect.Response[entpb.BatchUpdateGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.GroupService.BatchUpdate is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) BatchDelete(context.Context, *connect.Request[entpb.BatchDeleteGroupRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.GroupService.BatchDelete is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) StreamList(context.Context, *connect.Request[entpb.ListGroupRequest], *connect.ServerStream[entpb.StreamListGroupResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("entpb.GroupService.StreamList is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) Upsert(context.Context, *connect.Request[entpb.UpsertGroupRequest]) (*connect.Response[entpb.Group], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.GroupService.Upsert is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) Watch(context.Context, *connect.Request[entpb.WatchGroupRequest], *connect.ServerStream[entpb.WatchGroupResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("entpb.GroupService.Watch is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) Undelete(context.Context, *connect.Request[entpb.UndeleteGroupRequest]) (*connect.Response[entpb.Group], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.GroupService.Undelete is not implemented"))
+}
+
+func (UnimplementedGroupServiceHandler) Merge(context.Context, *connect.Request[entpb.MergeGroupsRequest]) (*connect.Response[entpb.MergeGroupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.GroupService.Merge is not implemented"))
+}
+
 // UserServiceClient is a client for the entpb.UserService service.
 type UserServiceClient interface {
 	Create(context.Context, *connect.Request[entpb.User]) (*connect.Response[entpb.User], error)
-	Get(context.Context, *connect.Request[wrapperspb.Int32Value]) (*connect.Response[entpb.User], error)
+	Get(context.Context, *connect.Request[entpb.GetUserRequest]) (*connect.Response[entpb.User], error)
 	Update(context.Context, *connect.Request[entpb.UpdateUserRequest]) (*connect.Response[entpb.User], error)
-	Delete(context.Context, *connect.Request[wrapperspb.Int32Value]) (*connect.Response[emptypb.Empty], error)
+	Delete(context.Context, *connect.Request[entpb.DeleteUserRequest]) (*connect.Response[emptypb.Empty], error)
 	List(context.Context, *connect.Request[entpb.ListUserRequest]) (*connect.Response[entpb.ListUserResponse], error)
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreateUserRequest]) (*connect.Response[entpb.BatchCreateUserResponse], error)
+	BatchGet(context.Context, *connect.Request[entpb.BatchGetUserRequest]) (*connect.Response[entpb.BatchGetUserResponse], error)
+	BatchUpdate(context.Context, *connect.Request[entpb.BatchUpdateUserRequest]) (*connect.Response[entpb.BatchUpdateUserResponse], error)
+	BatchDelete(context.Context, *connect.Request[entpb.BatchDeleteUserRequest]) (*connect.Response[emptypb.Empty], error)
+	StreamList(context.Context, *connect.Request[entpb.ListUserRequest]) (*connect.ServerStreamForClient[entpb.StreamListUserResponse], error)
+	Count(context.Context, *connect.Request[entpb.CountUserRequest]) (*connect.Response[entpb.CountUserResponse], error)
+	Aggregate(context.Context, *connect.Request[entpb.AggregateUserRequest]) (*connect.Response[entpb.AggregateUserResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the entpb.UserService service. By default, it uses
@@ -278,7 +575,7 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceCreateMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
-		get: connect.NewClient[wrapperspb.Int32Value, entpb.User](
+		get: connect.NewClient[entpb.GetUserRequest, entpb.User](
 			httpClient,
 			baseURL+UserServiceGetProcedure,
 			connect.WithSchema(userServiceGetMethodDescriptor),
@@ -291,7 +588,7 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceUpdateMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
-		delete: connect.NewClient[wrapperspb.Int32Value, emptypb.Empty](
+		delete: connect.NewClient[entpb.DeleteUserRequest, emptypb.Empty](
 			httpClient,
 			baseURL+UserServiceDeleteProcedure,
 			connect.WithSchema(userServiceDeleteMethodDescriptor),
@@ -304,16 +601,69 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		batchCreate: connect.NewClient[entpb.BatchCreateUserRequest, entpb.BatchCreateUserResponse](
+			httpClient,
+			baseURL+UserServiceBatchCreateProcedure,
+			connect.WithSchema(userServiceBatchCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		batchGet: connect.NewClient[entpb.BatchGetUserRequest, entpb.BatchGetUserResponse](
+			httpClient,
+			baseURL+UserServiceBatchGetProcedure,
+			connect.WithSchema(userServiceBatchGetMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		batchUpdate: connect.NewClient[entpb.BatchUpdateUserRequest, entpb.BatchUpdateUserResponse](
+			httpClient,
+			baseURL+UserServiceBatchUpdateProcedure,
+			connect.WithSchema(userServiceBatchUpdateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		batchDelete: connect.NewClient[entpb.BatchDeleteUserRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceBatchDeleteProcedure,
+			connect.WithSchema(userServiceBatchDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		streamList: connect.NewClient[entpb.ListUserRequest, entpb.StreamListUserResponse](
+			httpClient,
+			baseURL+UserServiceStreamListProcedure,
+			connect.WithSchema(userServiceStreamListMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		count: connect.NewClient[entpb.CountUserRequest, entpb.CountUserResponse](
+			httpClient,
+			baseURL+UserServiceCountProcedure,
+			connect.WithSchema(userServiceCountMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		aggregate: connect.NewClient[entpb.AggregateUserRequest, entpb.AggregateUserResponse](
+			httpClient,
+			baseURL+UserServiceAggregateProcedure,
+			connect.WithSchema(userServiceAggregateMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	create *connect.Client[entpb.User, entpb.User]
-	get    *connect.Client[wrapperspb.Int32Value, entpb.User]
-	update *connect.Client[entpb.UpdateUserRequest, entpb.User]
-	delete *connect.Client[wrapperspb.Int32Value, emptypb.Empty]
-	list   *connect.Client[entpb.ListUserRequest, entpb.ListUserResponse]
+	create      *connect.Client[entpb.User, entpb.User]
+	get         *connect.Client[entpb.GetUserRequest, entpb.User]
+	update      *connect.Client[entpb.UpdateUserRequest, entpb.User]
+	delete      *connect.Client[entpb.DeleteUserRequest, emptypb.Empty]
+	list        *connect.Client[entpb.ListUserRequest, entpb.ListUserResponse]
+	batchCreate *connect.Client[entpb.BatchCreateUserRequest, entpb.BatchCreateUserResponse]
+	batchGet    *connect.Client[entpb.BatchGetUserRequest, entpb.BatchGetUserResponse]
+	batchUpdate *connect.Client[entpb.BatchUpdateUserRequest, entpb.BatchUpdateUserResponse]
+	batchDelete *connect.Client[entpb.BatchDeleteUserRequest, emptypb.Empty]
+	streamList  *connect.Client[entpb.ListUserRequest, entpb.StreamListUserResponse]
+	count       *connect.Client[entpb.CountUserRequest, entpb.CountUserResponse]
+	aggregate   *connect.Client[entpb.AggregateUserRequest, entpb.AggregateUserResponse]
 }
 
 // Create calls entpb.UserService.Create.
@@ -322,7 +672,7 @@ func (c *userServiceClient) Create(ctx context.Context, req *connect.Request[ent
 }
 
 // Get calls entpb.UserService.Get.
-func (c *userServiceClient) Get(ctx context.Context, req *connect.Request[wrapperspb.Int32Value]) (*connect.Response[entpb.User], error) {
+func (c *userServiceClient) Get(ctx context.Context, req *connect.Request[entpb.GetUserRequest]) (*connect.Response[entpb.User], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
@@ -332,7 +682,7 @@ func (c *userServiceClient) Update(ctx context.Context, req *connect.Request[ent
 }
 
 // Delete calls entpb.UserService.Delete.
-func (c *userServiceClient) Delete(ctx context.Context, req *connect.Request[wrapperspb.Int32Value]) (*connect.Response[emptypb.Empty], error) {
+func (c *userServiceClient) Delete(ctx context.Context, req *connect.Request[entpb.DeleteUserRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
@@ -341,13 +691,55 @@ func (c *userServiceClient) List(ctx context.Context, req *connect.Request[entpb
 	return c.list.CallUnary(ctx, req)
 }
 
+// BatchCreate calls entpb.UserService.BatchCreate.
+func (c *userServiceClient) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreateUserRequest]) (*connect.Response[entpb.BatchCreateUserResponse], error) {
+	return c.batchCreate.CallUnary(ctx, req)
+}
+
+// BatchGet calls entpb.UserService.BatchGet.
+func (c *userServiceClient) BatchGet(ctx context.Context, req *connect.Request[entpb.BatchGetUserRequest]) (*connect.Response[entpb.BatchGetUserResponse], error) {
+	return c.batchGet.CallUnary(ctx, req)
+}
+
+// BatchUpdate calls entpb.UserService.BatchUpdate.
+func (c *userServiceClient) BatchUpdate(ctx context.Context, req *connect.Request[entpb.BatchUpdateUserRequest]) (*connect.Response[entpb.BatchUpdateUserResponse], error) {
+	return c.batchUpdate.CallUnary(ctx, req)
+}
+
+// BatchDelete calls entpb.UserService.BatchDelete.
+func (c *userServiceClient) BatchDelete(ctx context.Context, req *connect.Request[entpb.BatchDeleteUserRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.batchDelete.CallUnary(ctx, req)
+}
+
+// StreamList calls entpb.UserService.StreamList.
+func (c *userServiceClient) StreamList(ctx context.Context, req *connect.Request[entpb.ListUserRequest]) (*connect.ServerStreamForClient[entpb.StreamListUserResponse], error) {
+	return c.streamList.CallServerStream(ctx, req)
+}
+
+// Count calls entpb.UserService.Count.
+func (c *userServiceClient) Count(ctx context.Context, req *connect.Request[entpb.CountUserRequest]) (*connect.Response[entpb.CountUserResponse], error) {
+	return c.count.CallUnary(ctx, req)
+}
+
+// Aggregate calls entpb.UserService.Aggregate.
+func (c *userServiceClient) Aggregate(ctx context.Context, req *connect.Request[entpb.AggregateUserRequest]) (*connect.Response[entpb.AggregateUserResponse], error) {
+	return c.aggregate.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the entpb.UserService service.
 type UserServiceHandler interface {
 	Create(context.Context, *connect.Request[entpb.User]) (*connect.Response[entpb.User], error)
-	Get(context.Context, *connect.Request[wrapperspb.Int32Value]) (*connect.Response[entpb.User], error)
+	Get(context.Context, *connect.Request[entpb.GetUserRequest]) (*connect.Response[entpb.User], error)
 	Update(context.Context, *connect.Request[entpb.UpdateUserRequest]) (*connect.Response[entpb.User], error)
-	Delete(context.Context, *connect.Request[wrapperspb.Int32Value]) (*connect.Response[emptypb.Empty], error)
+	Delete(context.Context, *connect.Request[entpb.DeleteUserRequest]) (*connect.Response[emptypb.Empty], error)
 	List(context.Context, *connect.Request[entpb.ListUserRequest]) (*connect.Response[entpb.ListUserResponse], error)
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreateUserRequest]) (*connect.Response[entpb.BatchCreateUserResponse], error)
+	BatchGet(context.Context, *connect.Request[entpb.BatchGetUserRequest]) (*connect.Response[entpb.BatchGetUserResponse], error)
+	BatchUpdate(context.Context, *connect.Request[entpb.BatchUpdateUserRequest]) (*connect.Response[entpb.BatchUpdateUserResponse], error)
+	BatchDelete(context.Context, *connect.Request[entpb.BatchDeleteUserRequest]) (*connect.Response[emptypb.Empty], error)
+	StreamList(context.Context, *connect.Request[entpb.ListUserRequest], *connect.ServerStream[entpb.StreamListUserResponse]) error
+	Count(context.Context, *connect.Request[entpb.CountUserRequest]) (*connect.Response[entpb.CountUserResponse], error)
+	Aggregate(context.Context, *connect.Request[entpb.AggregateUserRequest]) (*connect.Response[entpb.AggregateUserResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -388,6 +780,52 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceBatchCreateHandler := connect.NewUnaryHandler(
+		UserServiceBatchCreateProcedure,
+		svc.BatchCreate,
+		connect.WithSchema(userServiceBatchCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceBatchGetHandler := connect.NewUnaryHandler(
+		UserServiceBatchGetProcedure,
+		svc.BatchGet,
+		connect.WithSchema(userServiceBatchGetMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceBatchUpdateHandler := connect.NewUnaryHandler(
+		UserServiceBatchUpdateProcedure,
+		svc.BatchUpdate,
+		connect.WithSchema(userServiceBatchUpdateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceBatchDeleteHandler := connect.NewUnaryHandler(
+		UserServiceBatchDeleteProcedure,
+		svc.BatchDelete,
+		connect.WithSchema(userServiceBatchDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceStreamListHandler := connect.NewServerStreamHandler(
+		UserServiceStreamListProcedure,
+		svc.StreamList,
+		connect.WithSchema(userServiceStreamListMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCountHandler := connect.NewUnaryHandler(
+		UserServiceCountProcedure,
+		svc.Count,
+		connect.WithSchema(userServiceCountMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceAggregateHandler := connect.NewUnaryHandler(
+		UserServiceAggregateProcedure,
+		svc.Aggregate,
+		connect.WithSchema(userServiceAggregateMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/entpb.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceCreateProcedure:
@@ -400,6 +838,20 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceDeleteHandler.ServeHTTP(w, r)
 		case UserServiceListProcedure:
 			userServiceListHandler.ServeHTTP(w, r)
+		case UserServiceBatchCreateProcedure:
+			userServiceBatchCreateHandler.ServeHTTP(w, r)
+		case UserServiceBatchGetProcedure:
+			userServiceBatchGetHandler.ServeHTTP(w, r)
+		case UserServiceBatchUpdateProcedure:
+			userServiceBatchUpdateHandler.ServeHTTP(w, r)
+		case UserServiceBatchDeleteProcedure:
+			userServiceBatchDeleteHandler.ServeHTTP(w, r)
+		case UserServiceStreamListProcedure:
+			userServiceStreamListHandler.ServeHTTP(w, r)
+		case UserServiceCountProcedure:
+			userServiceCountHandler.ServeHTTP(w, r)
+		case UserServiceAggregateProcedure:
+			userServiceAggregateHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -413,7 +865,7 @@ func (UnimplementedUserServiceHandler) Create(context.Context, *connect.Request[
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.Create is not implemented"))
 }
 
-func (UnimplementedUserServiceHandler) Get(context.Context, *connect.Request[wrapperspb.Int32Value]) (*connect.Response[entpb.User], error) {
+func (UnimplementedUserServiceHandler) Get(context.Context, *connect.Request[entpb.GetUserRequest]) (*connect.Response[entpb.User], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.Get is not implemented"))
 }
 
@@ -421,10 +873,38 @@ func (UnimplementedUserServiceHandler) Update(context.Context, *connect.Request[
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.Update is not implemented"))
 }
 
-func (UnimplementedUserServiceHandler) Delete(context.Context, *connect.Request[wrapperspb.Int32Value]) (*connect.Response[emptypb.Empty], error) {
+func (UnimplementedUserServiceHandler) Delete(context.Context, *connect.Request[entpb.DeleteUserRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.Delete is not implemented"))
 }
 
 func (UnimplementedUserServiceHandler) List(context.Context, *connect.Request[entpb.ListUserRequest]) (*connect.Response[entpb.ListUserResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.List is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) BatchCreate(context.Context, *connect.Request[entpb.BatchCreateUserRequest]) (*connect.Response[entpb.BatchCreateUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.BatchCreate is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) BatchGet(context.Context, *connect.Request[entpb.BatchGetUserRequest]) (*connect.Response[entpb.BatchGetUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.BatchGet is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) BatchUpdate(context.Context, *connect.Request[entpb.BatchUpdateUserRequest]) (*connect.Response[entpb.BatchUpdateUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.BatchUpdate is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) BatchDelete(context.Context, *connect.Request[entpb.BatchDeleteUserRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.BatchDelete is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) StreamList(context.Context, *connect.Request[entpb.ListUserRequest], *connect.ServerStream[entpb.StreamListUserResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.StreamList is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) Count(context.Context, *connect.Request[entpb.CountUserRequest]) (*connect.Response[entpb.CountUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.Count is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) Aggregate(context.Context, *connect.Request[entpb.AggregateUserRequest]) (*connect.Response[entpb.AggregateUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.Aggregate is not implemented"))
+}
@@ -8,11 +8,16 @@ import (
 	ent "github.com/yoshino-s/entproto/internal/test/ent"
 	entpb "github.com/yoshino-s/entproto/internal/test/proto/entpb"
 	runtime "github.com/yoshino-s/entproto/runtime"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ToProtoGroup transforms the ent type to the pb type
 func ToProtoGroup(e *ent.Group) (*entpb.Group, error) {
 	v := &entpb.Group{}
+	if e.DeletedAt != nil {
+		deleted_at := timestamppb.New(*e.DeletedAt)
+		v.DeletedAt = deleted_at
+	}
 	id := int32(e.ID)
 	v.Id = id
 	metadata, err := runtime.ToStructPbValue(e.Metadata)
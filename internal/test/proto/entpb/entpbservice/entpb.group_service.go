@@ -4,37 +4,54 @@ package entpbservice
 import (
 	connect "connectrpc.com/connect"
 	context "context"
-	gen "entgo.io/ent/entc/gen"
+	json "encoding/json"
+	errors1 "errors"
+	fmt "fmt"
 	errors "github.com/go-errors/errors"
 	ent "github.com/yoshino-s/entproto/internal/test/ent"
 	group "github.com/yoshino-s/entproto/internal/test/ent/group"
+	predicate "github.com/yoshino-s/entproto/internal/test/ent/predicate"
+	user "github.com/yoshino-s/entproto/internal/test/ent/user"
 	entpb "github.com/yoshino-s/entproto/internal/test/proto/entpb"
 	entpbconnect "github.com/yoshino-s/entproto/internal/test/proto/entpb/entpbconnect"
 	runtime "github.com/yoshino-s/entproto/runtime"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
-	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	time "time"
 )
 
+// GroupCustomMethods implements the methods of GroupServiceHandler declared with entproto.RPC.
+type GroupCustomMethods interface {
+	Merge(ctx context.Context, req *connect.Request[entpb.MergeGroupsRequest]) (*connect.Response[entpb.MergeGroupsResponse], error)
+}
+
 // GroupServiceHandler implements $connectHandler
 type GroupServiceHandler struct {
 	*runtime.BaseService
 	*ent.Client
+	Custom GroupCustomMethods
 }
 
 var _ entpbconnect.GroupServiceHandler = (*GroupServiceHandler)(nil)
 
-// NewGroupServiceHandler returns a new GroupServiceHandler
-func NewGroupServiceHandler(client *ent.Client) *GroupServiceHandler {
+// NewGroupServiceHandler returns a new GroupServiceHandler. It panics if custom is nil, as the methods
+// declared with entproto.RPC can't be served without it.
+func NewGroupServiceHandler(client *ent.Client, custom GroupCustomMethods) *GroupServiceHandler {
+	if custom == nil {
+		panic("entpbservice: NewGroupServiceHandler requires a GroupCustomMethods")
+	}
 	return &GroupServiceHandler{
 		BaseService: runtime.NewBaseService(),
 		Client:      client,
+		Custom:      custom,
 	}
 }
 
 // Create implements GroupServiceHandlerServer.Create
 func (svc *GroupServiceHandler) Create(ctx context.Context, req *connect.Request[entpb.Group]) (*connect.Response[entpb.Group], error) {
+
 	group := req.Msg
-	m, err := svc.createBuilder(group)
+	m, err := svc.createBuilder(svc.Client, group)
 	if err != nil {
 		return nil, err
 	}
@@ -47,6 +64,9 @@ func (svc *GroupServiceHandler) Create(ctx context.Context, req *connect.Request
 	if err != nil {
 		return nil, err
 	}
+	if id, ok := m.Mutation().ID(); ok {
+		svc.publish(ctx, runtime.EventCreated, id)
+	}
 	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterCreate, req, res); err != nil {
 		return nil, err
 	}
@@ -55,12 +75,34 @@ func (svc *GroupServiceHandler) Create(ctx context.Context, req *connect.Request
 }
 
 // Get implements GroupServiceHandlerServer.Get
-func (svc *GroupServiceHandler) Get(ctx context.Context, req *connect.Request[wrapperspb.Int32Value]) (*connect.Response[entpb.Group], error) {
+func (svc *GroupServiceHandler) Get(ctx context.Context, req *connect.Request[entpb.GetGroupRequest]) (*connect.Response[entpb.Group], error) {
+
+	id := int(req.Msg.GetId())
 
 	query := svc.Client.Group.Query()
 	query = query.Where(
-		group.ID(int(req.Msg.Value)),
+		group.ID(id),
 	)
+	if req.Msg.ShowDeleted {
+		if err := svc.CheckShowDeleted(ctx); err != nil {
+			return nil, err
+		}
+	} else {
+		query = query.Where(group.DeletedAtIsNil())
+	}
+	query, err := svc.loadEdges(query, req.Msg.With)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.ReadMask != nil {
+		columns, err := svc.readMaskColumns(req.Msg.ReadMask)
+		if err != nil {
+			return nil, err
+		}
+		if len(columns) > 0 {
+			query = query.Select(columns...).GroupQuery
+		}
+	}
 
 	if err := svc.RunHooks(ctx, runtime.ActionGet, req, query); err != nil {
 		return nil, err
@@ -69,6 +111,7 @@ func (svc *GroupServiceHandler) Get(ctx context.Context, req *connect.Request[wr
 	if err != nil {
 		return nil, err
 	}
+	runtime.ApplyReadMask(res.Msg, req.Msg.ReadMask)
 	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterGet, req, res); err != nil {
 		return nil, err
 	}
@@ -78,32 +121,11 @@ func (svc *GroupServiceHandler) Get(ctx context.Context, req *connect.Request[wr
 
 // Update implements GroupServiceHandlerServer.Update
 func (svc *GroupServiceHandler) Update(ctx context.Context, req *connect.Request[entpb.UpdateGroupRequest]) (*connect.Response[entpb.Group], error) {
+
 	group := req.Msg
-	groupID := int(group.GetId())
-	m := svc.Client.Group.UpdateOneID(groupID)
-	if group.GetMetadata() != nil {
-		var groupMetadataTmpObj ent.Group
-		groupMetadata := groupMetadataTmpObj.Metadata
-		if err := runtime.FromStructPbValue(group.GetMetadata(), &groupMetadata); err != nil {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid argument: %s", err))
-		}
-		m.SetMetadata(groupMetadata)
-	}
-	if group.GetName() != nil {
-		groupName := group.GetName().GetValue()
-		m.SetName(groupName)
-	}
-	if group.GetTags() != nil {
-		var groupTagsTmpObj ent.Group
-		groupTags := groupTagsTmpObj.Tags
-		if err := runtime.FromStructPbValue(group.GetTags(), &groupTags); err != nil {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid argument: %s", err))
-		}
-		m.SetTags(groupTags)
-	}
-	for _, item := range group.GetUsers() {
-		users := int(item.GetId())
-		m.AddUserIDs(users)
+	m, err := svc.updateBuilder(svc.Client, group)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := svc.RunHooks(ctx, runtime.ActionUpdate, req, m); err != nil {
@@ -114,6 +136,9 @@ func (svc *GroupServiceHandler) Update(ctx context.Context, req *connect.Request
 	if err != nil {
 		return nil, err
 	}
+	if id, ok := m.Mutation().ID(); ok {
+		svc.publish(ctx, runtime.EventUpdated, id)
+	}
 	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterUpdate, req, res); err != nil {
 		return nil, err
 	}
@@ -122,9 +147,12 @@ func (svc *GroupServiceHandler) Update(ctx context.Context, req *connect.Request
 }
 
 // Delete implements GroupServiceHandlerServer.Delete
-func (svc *GroupServiceHandler) Delete(ctx context.Context, req *connect.Request[wrapperspb.Int32Value]) (*connect.Response[emptypb.Empty], error) {
+func (svc *GroupServiceHandler) Delete(ctx context.Context, req *connect.Request[entpb.DeleteGroupRequest]) (*connect.Response[emptypb.Empty], error) {
 
-	query := svc.Client.Group.DeleteOneID(int(req.Msg.Value))
+	id := int(req.Msg.GetId())
+	query := svc.Client.Group.UpdateOneID(id).
+		Where(group.DeletedAtIsNil()).
+		SetDeletedAt(time.Now())
 	if err := svc.RunHooks(ctx, runtime.ActionDelete, req, query); err != nil {
 		return nil, err
 	}
@@ -132,6 +160,7 @@ func (svc *GroupServiceHandler) Delete(ctx context.Context, req *connect.Request
 	if err := query.Exec(ctx); err != nil {
 		return nil, wrapError(err)
 	}
+	svc.publish(ctx, runtime.EventDeleted, id)
 
 	res := connect.NewResponse(&emptypb.Empty{})
 	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterDelete, req, res); err != nil {
@@ -150,18 +179,40 @@ func (svc *GroupServiceHandler) List(ctx context.Context, req *connect.Request[e
 		return nil, wrapError(err)
 	}
 
-	items, err := WrapProtoGroupList(query.All(ctx))
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	items, err := ToProtoGroupList(entities)
 	if err != nil {
 		return nil, wrapError(err)
 	}
+	for _, item := range items {
+		runtime.ApplyReadMask(item, req.Msg.ReadMask)
+	}
 	total, err := totalQuery.Count(ctx)
 	if err != nil {
 		return nil, wrapError(err)
 	}
 
+	var nextPageToken string
+	if n := len(entities); !req.Msg.NoLimit && n > 0 && n == svc.listLimit(req) {
+		orderTerms, err := svc.listOrder(req)
+		if err != nil {
+			return nil, wrapError(err)
+		}
+		// Orders that don't support page tokens can only be paginated with offset, and get no next page token.
+		if svc.listCursorOrder(orderTerms) == nil {
+			if nextPageToken, err = svc.listPageToken(orderTerms, entities[n-1]); err != nil {
+				return nil, wrapError(err)
+			}
+		}
+	}
+
 	res := connect.NewResponse(&entpb.ListGroupResponse{
-		Items: items,
-		Total: int32(total),
+		Items:         items,
+		Total:         int32(total),
+		NextPageToken: nextPageToken,
 	})
 	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterList, req, res); err != nil {
 		return nil, err
@@ -170,35 +221,447 @@ func (svc *GroupServiceHandler) List(ctx context.Context, req *connect.Request[e
 
 }
 
-// List implements GroupServiceHandlerServer.List
-func (svc *GroupServiceHandler) BuildListQuery(ctx context.Context, req *connect.Request[entpb.ListGroupRequest]) (*ent.GroupQuery, *ent.GroupQuery, error) {
+// BatchCreate implements GroupServiceHandlerServer.BatchCreate
+func (svc *GroupServiceHandler) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreateGroupRequest]) (*connect.Response[entpb.BatchCreateGroupResponse], error) {
+
+	if len(req.Msg.Items) > 1000 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("batch size exceeds 1000"))
+	}
+	builders := make([]*ent.GroupCreate, 0, len(req.Msg.Items))
+	for _, item := range req.Msg.Items {
+		m, err := svc.createBuilder(svc.Client, item)
+		if err != nil {
+			return nil, err
+		}
+		if err := svc.RunHooks(ctx, runtime.ActionBatchCreate, req, m); err != nil {
+			return nil, err
+		}
+		builders = append(builders, m)
+	}
+
+	entities, err := svc.Client.Group.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	for _, e := range entities {
+		svc.publish(ctx, runtime.EventCreated, e.ID)
+	}
+	items, err := ToProtoGroupList(entities)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	res := connect.NewResponse(&entpb.BatchCreateGroupResponse{
+		Items: items,
+	})
+	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterBatchCreate, req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+
+}
+
+// BatchGet implements GroupServiceHandlerServer.BatchGet
+func (svc *GroupServiceHandler) BatchGet(ctx context.Context, req *connect.Request[entpb.BatchGetGroupRequest]) (*connect.Response[entpb.BatchGetGroupResponse], error) {
+
+	if len(req.Msg.Ids) > 1000 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("batch size exceeds 1000"))
+	}
+	ids := make([]int, 0, len(req.Msg.Ids))
+	for _, item := range req.Msg.Ids {
+		id := int(item)
+		ids = append(ids, id)
+	}
+
+	query := svc.Client.Group.Query().
+		Where(group.IDIn(ids...))
+	// Soft-deleted entities are reported as missing.
+	query = query.Where(group.DeletedAtIsNil())
+	if err := svc.RunHooks(ctx, runtime.ActionBatchGet, req, query); err != nil {
+		return nil, err
+	}
+
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	found := make(map[int]*ent.Group, len(entities))
+	for _, e := range entities {
+		found[e.ID] = e
+	}
+
+	// Entities are returned in the order of the request, IDs that weren't found are reported as missing.
+	msg := &entpb.BatchGetGroupResponse{}
+	ordered := make([]*ent.Group, 0, len(ids))
+	for i, id := range ids {
+		if e, ok := found[id]; ok {
+			ordered = append(ordered, e)
+		} else {
+			msg.MissingIds = append(msg.MissingIds, req.Msg.Ids[i])
+		}
+	}
+	msg.Items, err = ToProtoGroupList(ordered)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	res := connect.NewResponse(msg)
+	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterBatchGet, req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+
+}
+
+// BatchUpdate implements GroupServiceHandlerServer.BatchUpdate
+func (svc *GroupServiceHandler) BatchUpdate(ctx context.Context, req *connect.Request[entpb.BatchUpdateGroupRequest]) (*connect.Response[entpb.BatchUpdateGroupResponse], error) {
+
+	if len(req.Msg.Requests) > 1000 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("batch size exceeds 1000"))
+	}
+
+	// Each item runs the hooks of the Update method, the whole batch is rolled back if any of them fails.
+	var msg *entpb.BatchUpdateGroupResponse
+	err := runtime.RunInTx(ctx, svc.RetryPolicy(), svc.Client.Tx, func(ctx context.Context, tx *ent.Tx) error {
+		ctx = ent.NewTxContext(ctx, tx)
+		msg = &entpb.BatchUpdateGroupResponse{}
+		for _, item := range req.Msg.Requests {
+			m, err := svc.updateBuilder(tx.Client(), item)
+			if err != nil {
+				return err
+			}
+			itemReq := runtime.NewItemRequest(req, item)
+			if err := svc.RunHooks(ctx, runtime.ActionUpdate, itemReq, m); err != nil {
+				return err
+			}
+			itemRes, err := runtime.WrapResult(WrapProtoGroup(m.Save(ctx)))
+			if err != nil {
+				return err
+			}
+			if id, ok := m.Mutation().ID(); ok {
+				svc.publish(ctx, runtime.EventUpdated, id)
+			}
+			if err := svc.RunHooksAfter(ctx, runtime.ActionAfterUpdate, itemReq, itemRes); err != nil {
+				return err
+			}
+			msg.Items = append(msg.Items, itemRes.Msg)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return connect.NewResponse(msg), nil
+
+}
+
+// BatchDelete implements GroupServiceHandlerServer.BatchDelete
+func (svc *GroupServiceHandler) BatchDelete(ctx context.Context, req *connect.Request[entpb.BatchDeleteGroupRequest]) (*connect.Response[emptypb.Empty], error) {
+
+	if len(req.Msg.Ids) > 1000 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("batch size exceeds 1000"))
+	}
+	ids := make([]int, 0, len(req.Msg.Ids))
+	for _, item := range req.Msg.Ids {
+		id := int(item)
+		ids = append(ids, id)
+	}
+
+	// Each ID runs the hooks of the Delete method, the whole batch is rolled back if any of them fails.
+	err := runtime.RunInTx(ctx, svc.RetryPolicy(), svc.Client.Tx, func(ctx context.Context, tx *ent.Tx) error {
+		ctx = ent.NewTxContext(ctx, tx)
+		for i, id := range ids {
+			itemReq := runtime.NewItemRequest(req, &entpb.DeleteGroupRequest{
+				Id: req.Msg.Ids[i],
+			})
+			query := tx.Group.UpdateOneID(id).
+				Where(group.DeletedAtIsNil()).
+				SetDeletedAt(time.Now())
+			if err := svc.RunHooks(ctx, runtime.ActionDelete, itemReq, query); err != nil {
+				return err
+			}
+			if err := query.Exec(ctx); err != nil {
+				return err
+			}
+			svc.publish(ctx, runtime.EventDeleted, id)
+			itemRes := connect.NewResponse(&emptypb.Empty{})
+			if err := svc.RunHooksAfter(ctx, runtime.ActionAfterDelete, itemReq, itemRes); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+
+}
+
+// StreamList implements GroupServiceHandlerServer.StreamList
+func (svc *GroupServiceHandler) StreamList(ctx context.Context, req *connect.Request[entpb.ListGroupRequest], stream *connect.ServerStream[entpb.StreamListGroupResponse]) error {
+
+	if req.Msg.Offset != nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("offset is not supported by StreamList, use page_token"))
+	}
+	if req.Msg.NoLimit {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("no_limit is not supported by StreamList, use page_size"))
+	}
+	// BuildListQuery rejects the orders that don't support page tokens, which the batches following the first one are
+	// read after.
+	query, _, err := svc.BuildListQuery(ctx, req)
+	if err != nil {
+		return wrapError(err)
+	}
+	orderTerms, err := svc.listOrder(req)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// The entities are read in batches of page_size, each batch starting after the last entity of the previous one,
+	// and sent as one message.
+	batchSize := svc.listLimit(req)
+	batch := query.Clone().Limit(batchSize)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		entities, err := batch.All(ctx)
+		if err != nil {
+			return wrapError(err)
+		}
+		if len(entities) == 0 {
+			return nil
+		}
+		items, err := ToProtoGroupList(entities)
+		if err != nil {
+			return wrapError(err)
+		}
+		for _, item := range items {
+			runtime.ApplyReadMask(item, req.Msg.ReadMask)
+		}
+		msg := &entpb.StreamListGroupResponse{
+			Items: items,
+		}
+		if err := svc.RunHooksAfter(ctx, runtime.ActionAfterStreamList, req, msg); err != nil {
+			return err
+		}
+		if err := stream.Send(msg); err != nil {
+			return err
+		}
+		if len(entities) < batchSize {
+			return nil
+		}
+
+		pageToken, err := svc.listPageToken(orderTerms, entities[len(entities)-1])
+		if err != nil {
+			return wrapError(err)
+		}
+		afterCursor, err := svc.listPageTokenPredicate(pageToken, orderTerms)
+		if err != nil {
+			return wrapError(err)
+		}
+		batch = query.Clone().Where(afterCursor).Limit(batchSize)
+	}
+
+}
+
+// Upsert implements GroupServiceHandlerServer.Upsert
+func (svc *GroupServiceHandler) Upsert(ctx context.Context, req *connect.Request[entpb.UpsertGroupRequest]) (*connect.Response[entpb.Group], error) {
+
+	if req.Msg.GetGroup() == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("group is required"))
+	}
+	var columns []string
+	switch req.Msg.GetOnConflict() {
+	case entpb.GroupConflictTarget_GROUP_CONFLICT_TARGET_NAME:
+		columns = []string{group.FieldName}
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("on_conflict is required"))
+	}
+
+	m, err := svc.createBuilder(svc.Client, req.Msg.GetGroup())
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.RunHooks(ctx, runtime.ActionUpsert, req, m); err != nil {
+		return nil, err
+	}
+	if err := svc.upsertConflictDeleted(ctx, svc.Client, req.Msg.GetOnConflict(), m); err != nil {
+		return nil, err
+	}
+
+	groupID, err := m.OnConflictColumns(columns...).UpdateNewValues().ID(ctx)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	// Upsert can't tell whether the entity was created, changes are published as updates.
+	svc.publish(ctx, runtime.EventUpdated, groupID)
+	res, err := runtime.WrapResult(WrapProtoGroup(svc.Client.Group.Get(ctx, groupID)))
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterUpsert, req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+
+}
+
+// Watch implements GroupServiceHandlerServer.Watch
+func (svc *GroupServiceHandler) Watch(ctx context.Context, req *connect.Request[entpb.WatchGroupRequest], stream *connect.ServerStream[entpb.WatchGroupResponse]) error {
+
+	// The changes are matched against the filter by querying the changed entity, which soft-deleted entities are
+	// still matched against.
+	query := svc.Client.Group.Query()
+	if req.Msg.Filter != nil {
+		preds, err := svc.listFilter(req.Msg.Filter, 0)
+		if err != nil {
+			return err
+		}
+		query = query.Where(preds...)
+	}
+	if err := svc.RunHooks(ctx, runtime.ActionWatch, req, query); err != nil {
+		return err
+	}
+
+	events, err := svc.Broker().Subscribe(ctx, GroupWatchTopic)
+	if err != nil {
+		return wrapError(err)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-events:
+			if !ok {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				return connect.NewError(connect.CodeAborted, errors.New("watch fell behind the changes and must be restarted"))
+			}
+			msg, err := svc.watchEvent(ctx, query, event)
+			if err != nil {
+				return wrapError(err)
+			}
+			if msg == nil {
+				continue
+			}
+			if err := svc.RunHooksAfter(ctx, runtime.ActionAfterWatch, req, msg); errors1.Is(err, runtime.ErrSkipEvent) {
+				continue
+			} else if err != nil {
+				return err
+			}
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
+
+}
+
+// Undelete implements GroupServiceHandlerServer.Undelete
+func (svc *GroupServiceHandler) Undelete(ctx context.Context, req *connect.Request[entpb.UndeleteGroupRequest]) (*connect.Response[entpb.Group], error) {
+
+	id := int(req.Msg.GetId())
+	query := svc.Client.Group.UpdateOneID(id).
+		Where(group.DeletedAtNotNil()).
+		ClearDeletedAt()
+	if err := svc.RunHooks(ctx, runtime.ActionUndelete, req, query); err != nil {
+		return nil, err
+	}
 
-	snake := gen.Funcs["snake"].(func(string) string)
+	res, err := runtime.WrapResult(WrapProtoGroup(query.Save(ctx)))
+	if err != nil {
+		return nil, err
+	}
+	svc.publish(ctx, runtime.EventCreated, id)
+	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterUndelete, req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+
+}
+
+// Merge implements GroupServiceHandlerServer.Merge
+func (svc *GroupServiceHandler) Merge(ctx context.Context, req *connect.Request[entpb.MergeGroupsRequest]) (*connect.Response[entpb.MergeGroupsResponse], error) {
+	return svc.Custom.Merge(ctx, req)
+}
+
+// BuildListQuery builds the queries of the List and StreamList methods, returning the query of the
+// entities and the query counting them.
+func (svc *GroupServiceHandler) BuildListQuery(ctx context.Context, req *connect.Request[entpb.ListGroupRequest]) (*ent.GroupQuery, *ent.GroupQuery, error) {
 
 	query := svc.Client.Group.Query()
 	totalQuery := svc.Client.Group.Query()
 
 	if !req.Msg.NoLimit {
-		if req.Msg.Limit != nil && req.Msg.Limit.Value > 0 {
-			query = query.Limit(int(req.Msg.Limit.Value))
-		} else { // If no limit, set default limit
-			query = query.Limit(10)
-		}
+		query = query.Limit(svc.listLimit(req))
 	}
 	if req.Msg.Offset != nil {
 		query = query.Offset(int(req.Msg.Offset.Value))
 	}
-	if req.Msg.Order != nil {
-		OrderFunc := ent.Asc
-		if req.Msg.Descending {
-			OrderFunc = ent.Desc
+	orderTerms, err := svc.listOrder(req)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	// Without offset, the following pages can only be read with page tokens.
+	if req.Msg.Offset == nil && !req.Msg.NoLimit {
+		if err := svc.listCursorOrder(orderTerms); err != nil {
+			return nil, nil, err
 		}
-		query = query.Order(OrderFunc(snake(req.Msg.Order.Value)))
-	} else {
-		query = query.Order(ent.Asc("id"))
+	}
+	for _, term := range orderTerms {
+		if term.Descending {
+			query = query.Order(ent.Desc(term.Column))
+		} else {
+			query = query.Order(ent.Asc(term.Column))
+		}
+	}
+	if query, err = svc.loadEdges(query, req.Msg.With); err != nil {
+		return nil, nil, err
+	}
+	if req.Msg.ReadMask != nil {
+		columns, err := svc.readMaskColumns(req.Msg.ReadMask)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(columns) > 0 {
+			// The order columns are needed to build the next page token.
+			for _, term := range orderTerms {
+				columns = append(columns, term.Column)
+			}
+			query = query.Select(columns...).GroupQuery
+		}
+	}
+	if req.Msg.PageToken != "" {
+		if req.Msg.Offset != nil {
+			return nil, nil, connect.NewError(connect.CodeInvalidArgument, errors.New("page_token and offset cannot be used together"))
+		}
+		afterCursor, err := svc.listPageTokenPredicate(req.Msg.PageToken, orderTerms)
+		if err != nil {
+			return nil, nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		query = query.Where(afterCursor)
 	}
 
 	if req.Msg.Filter != nil {
+		preds, err := svc.listFilter(req.Msg.Filter, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		query = query.Where(preds...)
+		totalQuery = totalQuery.Where(preds...)
+	}
+	if req.Msg.ShowDeleted {
+		if err := svc.CheckShowDeleted(ctx); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		query = query.Where(group.DeletedAtIsNil())
+		totalQuery = totalQuery.Where(group.DeletedAtIsNil())
 	}
 
 	if err := svc.RunHooks(ctx, runtime.ActionList, req, query); err != nil {
@@ -212,8 +675,310 @@ func (svc *GroupServiceHandler) BuildListQuery(ctx context.Context, req *connect
 
 }
 
-func (svc *GroupServiceHandler) createBuilder(group *entpb.Group) (*ent.GroupCreate, error) {
-	m := svc.Client.Group.Create()
+// listFilter returns the predicates selecting the entities matching a List filter. The and, or and not sub-filters
+// are applied recursively, up to the maximum filter depth of the service.
+func (svc *GroupServiceHandler) listFilter(f *entpb.ListGroupFilter, depth int) ([]predicate.Group, error) {
+	if depth > svc.MaxFilterDepth() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("filters can't be nested more than %d levels deep", svc.MaxFilterDepth()))
+	}
+	preds := []predicate.Group{}
+
+	if f.GetHasUsers() != nil {
+		if f.GetHasUsers().GetValue() {
+			preds = append(preds, group.HasUsers())
+		} else {
+			preds = append(preds, group.Not(group.HasUsers()))
+		}
+	}
+
+	if f.GetUsersId() != nil {
+		filterUsersId := int(f.GetUsersId().GetValue())
+		preds = append(preds, group.HasUsersWith(user.ID(filterUsersId)))
+	}
+	if len(f.And) > 0 {
+		andPreds := []predicate.Group{}
+		for _, sub := range f.And {
+			subPreds, err := svc.listFilter(sub, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			andPreds = append(andPreds, subPreds...)
+		}
+		if len(andPreds) > 0 {
+			preds = append(preds, group.And(andPreds...))
+		}
+	}
+	if len(f.Or) > 0 {
+		orPreds := []predicate.Group{}
+		for _, sub := range f.Or {
+			subPreds, err := svc.listFilter(sub, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			if len(subPreds) == 0 {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("or sub-filters can't be empty"))
+			}
+			orPreds = append(orPreds, group.And(subPreds...))
+		}
+		preds = append(preds, group.Or(orPreds...))
+	}
+	if f.Not != nil {
+		subPreds, err := svc.listFilter(f.Not, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		if len(subPreds) == 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("not sub-filter can't be empty"))
+		}
+		preds = append(preds, group.Not(group.And(subPreds...)))
+	}
+	return preds, nil
+}
+
+// listLimit returns the maximum number of entities returned by a List call.
+func (svc *GroupServiceHandler) listLimit(req *connect.Request[entpb.ListGroupRequest]) int {
+	if req.Msg.Limit != nil && req.Msg.Limit.Value > 0 {
+		return int(req.Msg.Limit.Value)
+	}
+	return 10 // If no limit, set default limit
+}
+
+// listOrder returns the order of a List call. The tie-breaker is appended to the order requested by the client, or
+// to the default order if the client didn't request any.
+func (svc *GroupServiceHandler) listOrder(req *connect.Request[entpb.ListGroupRequest]) ([]runtime.OrderTerm, error) {
+	orderTerms := make([]runtime.OrderTerm, 0, len(req.Msg.Order)+1)
+	for _, o := range req.Msg.Order {
+		var column string
+		switch o.GetField() {
+		case entpb.GroupOrderField_GROUP_ORDER_FIELD_ID:
+			column = group.FieldID
+		default:
+			return nil, fmt.Errorf("unknown order field %s", o.GetField())
+		}
+		orderTerms = append(orderTerms, runtime.OrderTerm{Column: column, Descending: o.GetDescending()})
+	}
+	if len(orderTerms) == 0 {
+		orderTerms = append(orderTerms,
+			runtime.OrderTerm{Column: group.FieldID, Descending: false},
+		)
+	}
+	return runtime.NormalizeOrder(orderTerms, group.FieldID)
+}
+
+// listCursorValue returns the value of the column of the given entity. It reports false if the column doesn't
+// support keyset pagination.
+func (svc *GroupServiceHandler) listCursorValue(column string, e *ent.Group) (any, bool) {
+	switch column {
+	case group.FieldID:
+		return e.ID, true
+	default:
+		return nil, false
+	}
+}
+
+// listCursorDecode decodes the JSON encoded value of the column stored in a page token.
+func (svc *GroupServiceHandler) listCursorDecode(column string, raw json.RawMessage) (any, error) {
+	var (
+		e   ent.Group
+		err error
+	)
+	switch column {
+	case group.FieldID:
+		err = json.Unmarshal(raw, &e.ID)
+		return e.ID, err
+	default:
+		return nil, fmt.Errorf("ordering by %q does not support page tokens", column)
+	}
+}
+
+// listCursorOrder checks the columns of the order support keyset pagination, which the page tokens rely on.
+func (svc *GroupServiceHandler) listCursorOrder(orderTerms []runtime.OrderTerm) error {
+	for _, term := range orderTerms {
+		if _, ok := svc.listCursorValue(term.Column, &ent.Group{}); !ok {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ordering by %q does not support page tokens, use offset", term.Column))
+		}
+	}
+	return nil
+}
+
+// listPageToken returns the token of the page following the given entity. The order must pass listCursorOrder.
+func (svc *GroupServiceHandler) listPageToken(orderTerms []runtime.OrderTerm, lastEntity *ent.Group) (string, error) {
+	cursorValues := make([]any, len(orderTerms))
+	for i, term := range orderTerms {
+		v, ok := svc.listCursorValue(term.Column, lastEntity)
+		if !ok {
+			return "", fmt.Errorf("ordering by %q does not support page tokens", term.Column)
+		}
+		cursorValues[i] = v
+	}
+	return runtime.EncodePageToken(orderTerms, cursorValues)
+}
+
+// listPageTokenPredicate returns the predicate selecting the entities following the position of a page token.
+func (svc *GroupServiceHandler) listPageTokenPredicate(rawToken string, orderTerms []runtime.OrderTerm) (predicate.Group, error) {
+	pageToken, err := runtime.DecodePageToken(rawToken, orderTerms)
+	if err != nil {
+		return nil, err
+	}
+	cursorValues := make([]any, len(orderTerms))
+	for i, term := range orderTerms {
+		if cursorValues[i], err = svc.listCursorDecode(term.Column, pageToken.Values[i]); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrInvalidPageToken, err)
+		}
+	}
+	return predicate.Group(runtime.KeysetPredicate(orderTerms, cursorValues)), nil
+}
+
+// GroupWatchTopic is the topic of the events of the Group entities. Ent hooks
+// publishing the changes made outside of GroupServiceHandler use it along with the broker of the service.
+const GroupWatchTopic = "entpb.Group"
+
+// publish publishes the changes of the entities with the given IDs to the broker of the service, once the
+// transaction of ctx, if any, is committed.
+func (svc *GroupServiceHandler) publish(ctx context.Context, eventType runtime.EventType, ids ...int) {
+	send := func(ctx context.Context) {
+		for _, id := range ids {
+			svc.Broker().Publish(ctx, runtime.Event{
+				Type:  eventType,
+				Topic: GroupWatchTopic,
+				ID:    id,
+			})
+		}
+	}
+	tx := ent.TxFromContext(ctx)
+	if tx == nil {
+		send(ctx)
+		return
+	}
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			send(ctx)
+			return nil
+		})
+	})
+}
+
+// watchEvent returns the message reporting event to a Watch request, or nil if the changed entity doesn't match
+// its query.
+func (svc *GroupServiceHandler) watchEvent(ctx context.Context, query *ent.GroupQuery, event runtime.Event) (*entpb.WatchGroupResponse, error) {
+	id, ok := event.ID.(int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected id type %T of %s event", event.ID, GroupWatchTopic)
+	}
+	pbID := int32(id)
+	msg := &entpb.WatchGroupResponse{
+		Id: pbID,
+	}
+	switch event.Type {
+	case runtime.EventCreated:
+		msg.Type = entpb.GroupWatchEventType_GROUP_WATCH_EVENT_TYPE_CREATED
+	case runtime.EventUpdated:
+		msg.Type = entpb.GroupWatchEventType_GROUP_WATCH_EVENT_TYPE_UPDATED
+	case runtime.EventDeleted:
+		msg.Type = entpb.GroupWatchEventType_GROUP_WATCH_EVENT_TYPE_DELETED
+	default:
+		return nil, nil
+	}
+	query = query.Clone().Where(group.ID(id))
+	if event.Type == runtime.EventDeleted {
+		query = query.Where(group.DeletedAtNotNil())
+	} else {
+		query = query.Where(group.DeletedAtIsNil())
+	}
+
+	entity, err := query.Only(ctx)
+	if ent.IsNotFound(err) {
+		// The entity doesn't match the query, or was deleted or undeleted since.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	msg.Group, err = ToProtoGroup(entity)
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// upsertConflictDeleted returns a FailedPrecondition error if the entity of an Upsert conflicts with a soft-deleted
+// entity, which the upsert would update while leaving it deleted. Deleted entities are restored with Undelete.
+func (svc *GroupServiceHandler) upsertConflictDeleted(ctx context.Context, client *ent.Client, target entpb.GroupConflictTarget, m *ent.GroupCreate) error {
+	preds := []predicate.Group{group.DeletedAtNotNil()}
+	switch target {
+	case entpb.GroupConflictTarget_GROUP_CONFLICT_TARGET_NAME:
+		conflictName, ok := m.Mutation().Name()
+		if !ok {
+			// Unset values are stored as NULL, which doesn't conflict.
+			return nil
+		}
+		preds = append(preds, group.NameEQ(conflictName))
+	default:
+		return nil
+	}
+	deleted, err := client.Group.Query().Where(preds...).Exist(ctx)
+	if err != nil {
+		return wrapError(err)
+	}
+	if deleted {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("group conflicts with a deleted group, undelete it first"))
+	}
+	return nil
+}
+
+// loadEdges eager-loads the edges selected by a Get or List request, or the default edges of the service if the
+// request selects none.
+func (svc *GroupServiceHandler) loadEdges(query *ent.GroupQuery, edges []entpb.GroupEdge) (*ent.GroupQuery, error) {
+	if len(edges) == 0 {
+		edges = []entpb.GroupEdge{
+			entpb.GroupEdge_GROUP_EDGE_USERS,
+		}
+	}
+	for _, e := range edges {
+		switch e {
+		case entpb.GroupEdge_GROUP_EDGE_USERS:
+			query = query.WithUsers()
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown edge %s", e))
+		}
+	}
+	return query, nil
+}
+
+// readMaskColumns returns the columns selected by the read mask of a Get or List request, or nil to select all of
+// them. Paths that aren't fields of Group are rejected.
+func (svc *GroupServiceHandler) readMaskColumns(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	columns := make([]string, 0, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "*":
+			return nil, nil
+		case "deleted_at":
+			columns = append(columns, group.FieldDeletedAt)
+		case "id":
+			columns = append(columns, group.FieldID)
+		case "metadata":
+			columns = append(columns, group.FieldMetadata)
+		case "name":
+			columns = append(columns, group.FieldName)
+		case "tags":
+			columns = append(columns, group.FieldTags)
+		case "users":
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown read_mask path %q", path))
+		}
+	}
+	return columns, nil
+}
+
+func (svc *GroupServiceHandler) createBuilder(client *ent.Client, group *entpb.Group) (*ent.GroupCreate, error) {
+	m := client.Group.Create()
+	if group.GetDeletedAt() != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("deleted_at is read-only, use the Delete and Undelete methods"))
+	}
 	var groupMetadataTmpObj ent.Group
 	groupMetadata := groupMetadataTmpObj.Metadata
 	if err := runtime.FromStructPbValue(group.GetMetadata(), &groupMetadata); err != nil {
@@ -234,3 +999,65 @@ func (svc *GroupServiceHandler) createBuilder(group *entpb.Group) (*ent.GroupCre
 	}
 	return m, nil
 }
+
+func (svc *GroupServiceHandler) updateBuilder(client *ent.Client, req *entpb.UpdateGroupRequest) (*ent.GroupUpdateOne, error) {
+	groupID := int(req.GetId())
+	m := client.Group.UpdateOneID(groupID).Where(group.DeletedAtIsNil())
+	group := req
+	updatePaths, err := runtime.UpdateMaskPaths(group.GetUpdateMask(), group, []string{"users"}, "id", "update_mask", "deleted_at", "add_users_ids", "remove_users_ids")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if group.GetDeletedAt() != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("deleted_at is read-only, use the Delete and Undelete methods"))
+	}
+	if group.GetMetadata() != nil && (updatePaths == nil || updatePaths["metadata"]) {
+		var groupMetadataTmpObj ent.Group
+		groupMetadata := groupMetadataTmpObj.Metadata
+		if err := runtime.FromStructPbValue(group.GetMetadata(), &groupMetadata); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid argument: %s", err))
+		}
+		m.SetMetadata(groupMetadata)
+	} else if updatePaths["metadata"] {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("metadata is required and can't be cleared"))
+	}
+	if group.GetName() != nil && (updatePaths == nil || updatePaths["name"]) {
+		groupName := group.GetName().GetValue()
+		m.SetName(groupName)
+	} else if updatePaths["name"] {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required and can't be cleared"))
+	}
+	if group.GetTags() != nil && (updatePaths == nil || updatePaths["tags"]) {
+		var groupTagsTmpObj ent.Group
+		groupTags := groupTagsTmpObj.Tags
+		if err := runtime.FromStructPbValue(group.GetTags(), &groupTags); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid argument: %s", err))
+		}
+		m.SetTags(groupTags)
+	} else if updatePaths["tags"] {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("tags is required and can't be cleared"))
+	}
+	replaceUsers := (updatePaths == nil && len(group.GetUsers()) > 0) || updatePaths["users"]
+	if replaceUsers && len(group.GetAddUsersIds()) > 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("users can't be combined with add_users_ids"))
+	}
+	if replaceUsers && len(group.GetRemoveUsersIds()) > 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("users can't be combined with remove_users_ids"))
+	}
+	if replaceUsers {
+		m.ClearUsers()
+		for _, item := range group.GetUsers() {
+			users := int(item.GetId())
+			m.AddUserIDs(users)
+		}
+	}
+	for _, item := range group.GetAddUsersIds() {
+		addUsersID := int(item)
+		m.AddUserIDs(addUsersID)
+	}
+	for _, item := range group.GetRemoveUsersIds() {
+		removeUsersID := int(item)
+		m.RemoveUserIDs(removeUsersID)
+	}
+	return m, nil
+}
@@ -4,7 +4,8 @@ package entpbservice
 import (
 	connect "connectrpc.com/connect"
 	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
-	errors "github.com/go-errors/errors"
+	errors "errors"
+	errors1 "github.com/go-errors/errors"
 	ent "github.com/yoshino-s/entproto/internal/test/ent"
 	_ "github.com/yoshino-s/entproto/internal/test/proto/entpb"
 )
@@ -13,13 +14,15 @@ func wrapError(err error) error {
 	switch {
 	case err == nil:
 		return nil
+	case errors.As(err, new(*connect.Error)):
+		return err
 	case ent.IsNotFound(err):
-		return connect.NewError(connect.CodeNotFound, errors.Errorf("not found: %s", err))
+		return connect.NewError(connect.CodeNotFound, errors1.Errorf("not found: %s", err))
 	case sqlgraph.IsUniqueConstraintError(err):
-		return connect.NewError(connect.CodeAlreadyExists, errors.Errorf("already exists: %s", err))
+		return connect.NewError(connect.CodeAlreadyExists, errors1.Errorf("already exists: %s", err))
 	case ent.IsConstraintError(err):
-		return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid argument: %s", err))
+		return connect.NewError(connect.CodeInvalidArgument, errors1.Errorf("invalid argument: %s", err))
 	default:
-		return connect.NewError(connect.CodeInternal, errors.Errorf("internal error: %s", err))
+		return connect.NewError(connect.CodeInternal, errors1.Errorf("internal error: %s", err))
 	}
 }
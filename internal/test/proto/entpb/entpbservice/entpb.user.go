@@ -54,6 +54,8 @@ func ToProtoUser(e *ent.User) (*entpb.User, error) {
 	v.Id = id
 	name := e.Name
 	v.Name = name
+	points := int32(e.Points)
+	v.Points = points
 	preferences, err := runtime.ToStructPbValue(e.Preferences)
 	if err != nil {
 		return nil, err
@@ -4,64 +4,101 @@ package entpbservice
 import (
 	connect "connectrpc.com/connect"
 	context "context"
-	gen "entgo.io/ent/entc/gen"
+	json "encoding/json"
+	fmt "fmt"
 	errors "github.com/go-errors/errors"
 	ent "github.com/yoshino-s/entproto/internal/test/ent"
+	group "github.com/yoshino-s/entproto/internal/test/ent/group"
+	predicate "github.com/yoshino-s/entproto/internal/test/ent/predicate"
 	user "github.com/yoshino-s/entproto/internal/test/ent/user"
 	entpb "github.com/yoshino-s/entproto/internal/test/proto/entpb"
 	entpbconnect "github.com/yoshino-s/entproto/internal/test/proto/entpb/entpbconnect"
 	runtime "github.com/yoshino-s/entproto/runtime"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
-	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	time "time"
 )
 
+// UserExtraFilterApplier applies the fields of ListUserFilter declared with entproto.ExtraFilter to the
+// queries of the List method.
+type UserExtraFilterApplier interface {
+	// ApplyPrefix applies the prefix filter.
+	ApplyPrefix(ctx context.Context, query *ent.UserQuery, value string) (*ent.UserQuery, error)
+}
+
 // UserServiceHandler implements $connectHandler
 type UserServiceHandler struct {
 	*runtime.BaseService
 	*ent.Client
+	ExtraFilters UserExtraFilterApplier
 }
 
 var _ entpbconnect.UserServiceHandler = (*UserServiceHandler)(nil)
 
-// NewUserServiceHandler returns a new UserServiceHandler
-func NewUserServiceHandler(client *ent.Client) *UserServiceHandler {
+// NewUserServiceHandler returns a new UserServiceHandler. Handlers must be built with it rather than with a
+// struct literal, which leaves the BaseService unset. The requests setting the extra fields of
+// ListUserFilter fail with Internal if extraFilters is nil.
+func NewUserServiceHandler(client *ent.Client, extraFilters UserExtraFilterApplier) *UserServiceHandler {
 	return &UserServiceHandler{
-		BaseService: runtime.NewBaseService(),
-		Client:      client,
+		BaseService:  runtime.NewBaseService(),
+		Client:       client,
+		ExtraFilters: extraFilters,
 	}
 }
 
 // Create implements UserServiceHandlerServer.Create
 func (svc *UserServiceHandler) Create(ctx context.Context, req *connect.Request[entpb.User]) (*connect.Response[entpb.User], error) {
-	user := req.Msg
-	m, err := svc.createBuilder(user)
-	if err != nil {
-		return nil, err
-	}
 
-	if err := svc.RunHooks(ctx, runtime.ActionCreate, req, m); err != nil {
-		return nil, err
-	}
-
-	res, err := runtime.WrapResult(WrapProtoUser(m.Save(ctx)))
+	user := req.Msg
+	var res *connect.Response[entpb.User]
+	err := runtime.RunInTx(ctx, svc.RetryPolicy(), svc.Client.Tx, func(ctx context.Context, tx *ent.Tx) error {
+		ctx = ent.NewTxContext(ctx, tx)
+		m, err := svc.createBuilder(tx.Client(), user)
+		if err != nil {
+			return err
+		}
+		if err := svc.RunHooks(ctx, runtime.ActionCreate, req, m); err != nil {
+			return err
+		}
+		res, err = runtime.WrapResult(WrapProtoUser(m.Save(ctx)))
+		if err != nil {
+			return err
+		}
+		if err := svc.RunHooksAfter(ctx, runtime.ActionAfterCreate, req, res); err != nil {
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, err
-	}
-	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterCreate, req, res); err != nil {
-		return nil, err
+		return nil, wrapError(err)
 	}
 	return res, nil
 
 }
 
 // Get implements UserServiceHandlerServer.Get
-func (svc *UserServiceHandler) Get(ctx context.Context, req *connect.Request[wrapperspb.Int32Value]) (*connect.Response[entpb.User], error) {
+func (svc *UserServiceHandler) Get(ctx context.Context, req *connect.Request[entpb.GetUserRequest]) (*connect.Response[entpb.User], error) {
+
+	id := int(req.Msg.GetId())
 
 	query := svc.Client.User.Query()
 	query = query.Where(
-		user.ID(int(req.Msg.Value)),
+		user.ID(id),
 	)
+	query, err := svc.loadEdges(query, req.Msg.With)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.ReadMask != nil {
+		columns, err := svc.readMaskColumns(req.Msg.ReadMask)
+		if err != nil {
+			return nil, err
+		}
+		if len(columns) > 0 {
+			query = query.Select(columns...).UserQuery
+		}
+	}
 
 	if err := svc.RunHooks(ctx, runtime.ActionGet, req, query); err != nil {
 		return nil, err
@@ -70,6 +107,7 @@ func (svc *UserServiceHandler) Get(ctx context.Context, req *connect.Request[wra
 	if err != nil {
 		return nil, err
 	}
+	runtime.ApplyReadMask(res.Msg, req.Msg.ReadMask)
 	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterGet, req, res); err != nil {
 		return nil, err
 	}
@@ -79,198 +117,952 @@ func (svc *UserServiceHandler) Get(ctx context.Context, req *connect.Request[wra
 
 // Update implements UserServiceHandlerServer.Update
 func (svc *UserServiceHandler) Update(ctx context.Context, req *connect.Request[entpb.UpdateUserRequest]) (*connect.Response[entpb.User], error) {
+
 	user := req.Msg
-	userID := int(user.GetId())
-	m := svc.Client.User.UpdateOneID(userID)
-	if user.GetDescription() != nil {
-		userDescription := user.GetDescription().GetValue()
-		m.SetDescription(userDescription)
+	var res *connect.Response[entpb.User]
+	err := runtime.RunInTx(ctx, svc.RetryPolicy(), svc.Client.Tx, func(ctx context.Context, tx *ent.Tx) error {
+		ctx = ent.NewTxContext(ctx, tx)
+		m, err := svc.updateBuilder(tx.Client(), user)
+		if err != nil {
+			return err
+		}
+		if err := svc.RunHooks(ctx, runtime.ActionUpdate, req, m); err != nil {
+			return err
+		}
+		res, err = runtime.WrapResult(WrapProtoUser(m.Save(ctx)))
+		if err != nil {
+			return err
+		}
+		if err := svc.RunHooksAfter(ctx, runtime.ActionAfterUpdate, req, res); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, wrapError(err)
 	}
-	if user.GetGender() != nil {
-		userGender := toEntUser_Gender(user.GetGender().GetValue())
-		m.SetGender(userGender)
+	return res, nil
+
+}
+
+// Delete implements UserServiceHandlerServer.Delete
+func (svc *UserServiceHandler) Delete(ctx context.Context, req *connect.Request[entpb.DeleteUserRequest]) (*connect.Response[emptypb.Empty], error) {
+
+	id := int(req.Msg.GetId())
+	var res *connect.Response[emptypb.Empty]
+	err := runtime.RunInTx(ctx, svc.RetryPolicy(), svc.Client.Tx, func(ctx context.Context, tx *ent.Tx) error {
+		ctx = ent.NewTxContext(ctx, tx)
+		query := tx.User.DeleteOneID(id)
+		if err := svc.RunHooks(ctx, runtime.ActionDelete, req, query); err != nil {
+			return err
+		}
+		if err := query.Exec(ctx); err != nil {
+			return err
+		}
+		res = connect.NewResponse(&emptypb.Empty{})
+		if err := svc.RunHooksAfter(ctx, runtime.ActionAfterDelete, req, res); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, wrapError(err)
 	}
-	if user.GetGroupId() != nil {
-		userGroupID := int(user.GetGroupId().GetValue())
-		m.SetGroupID(userGroupID)
+	return res, nil
+
+}
+
+// List implements UserServiceHandlerServer.List
+func (svc *UserServiceHandler) List(ctx context.Context, req *connect.Request[entpb.ListUserRequest]) (*connect.Response[entpb.ListUserResponse], error) {
+
+	query, totalQuery, err := svc.BuildListQuery(ctx, req)
+
+	if err != nil {
+		return nil, wrapError(err)
 	}
-	if user.GetName() != nil {
-		userName := user.GetName().GetValue()
-		m.SetName(userName)
+
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, wrapError(err)
 	}
-	if user.GetPreferences() != nil {
-		var userPreferencesTmpObj ent.User
-		userPreferences := userPreferencesTmpObj.Preferences
-		if err := runtime.FromStructPbValue(user.GetPreferences(), &userPreferences); err != nil {
-			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid argument: %s", err))
-		}
-		m.SetPreferences(userPreferences)
+	items, err := ToProtoUserList(entities)
+	if err != nil {
+		return nil, wrapError(err)
 	}
-	if user.GetGroup() != nil {
-		userGroup := int(user.GetGroup().GetId())
-		m.SetGroupID(userGroup)
+	for _, item := range items {
+		runtime.ApplyReadMask(item, req.Msg.ReadMask)
+	}
+	total, err := totalQuery.Count(ctx)
+	if err != nil {
+		return nil, wrapError(err)
 	}
 
-	if err := svc.RunHooks(ctx, runtime.ActionUpdate, req, m); err != nil {
-		return nil, err
+	var nextPageToken string
+	if n := len(entities); !req.Msg.NoLimit && n > 0 && n == svc.listLimit(req) {
+		orderTerms, err := svc.listOrder(req)
+		if err != nil {
+			return nil, wrapError(err)
+		}
+		// Orders that don't support page tokens can only be paginated with offset, and get no next page token.
+		if svc.listCursorOrder(orderTerms) == nil {
+			if nextPageToken, err = svc.listPageToken(orderTerms, entities[n-1]); err != nil {
+				return nil, wrapError(err)
+			}
+		}
 	}
 
-	res, err := runtime.WrapResult(WrapProtoUser(m.Save(ctx)))
-	if err != nil {
+	res := connect.NewResponse(&entpb.ListUserResponse{
+		Items:         items,
+		Total:         int32(total),
+		NextPageToken: nextPageToken,
+	})
+	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterList, req, res); err != nil {
 		return nil, err
 	}
-	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterUpdate, req, res); err != nil {
-		return nil, err
+	return res, nil
+
+}
+
+// BatchCreate implements UserServiceHandlerServer.BatchCreate
+func (svc *UserServiceHandler) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreateUserRequest]) (*connect.Response[entpb.BatchCreateUserResponse], error) {
+
+	if len(req.Msg.Items) > 1000 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("batch size exceeds 1000"))
+	}
+	var res *connect.Response[entpb.BatchCreateUserResponse]
+	err := runtime.RunInTx(ctx, svc.RetryPolicy(), svc.Client.Tx, func(ctx context.Context, tx *ent.Tx) error {
+		ctx = ent.NewTxContext(ctx, tx)
+		builders := make([]*ent.UserCreate, 0, len(req.Msg.Items))
+		for _, item := range req.Msg.Items {
+			m, err := svc.createBuilder(tx.Client(), item)
+			if err != nil {
+				return err
+			}
+			if err := svc.RunHooks(ctx, runtime.ActionBatchCreate, req, m); err != nil {
+				return err
+			}
+			builders = append(builders, m)
+		}
+
+		entities, err := tx.User.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return err
+		}
+		items, err := ToProtoUserList(entities)
+		if err != nil {
+			return err
+		}
+
+		res = connect.NewResponse(&entpb.BatchCreateUserResponse{
+			Items: items,
+		})
+		if err := svc.RunHooksAfter(ctx, runtime.ActionAfterBatchCreate, req, res); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, wrapError(err)
 	}
 	return res, nil
 
 }
 
-// Delete implements UserServiceHandlerServer.Delete
-func (svc *UserServiceHandler) Delete(ctx context.Context, req *connect.Request[wrapperspb.Int32Value]) (*connect.Response[emptypb.Empty], error) {
+// BatchGet implements UserServiceHandlerServer.BatchGet
+func (svc *UserServiceHandler) BatchGet(ctx context.Context, req *connect.Request[entpb.BatchGetUserRequest]) (*connect.Response[entpb.BatchGetUserResponse], error) {
+
+	if len(req.Msg.Ids) > 1000 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("batch size exceeds 1000"))
+	}
+	ids := make([]int, 0, len(req.Msg.Ids))
+	for _, item := range req.Msg.Ids {
+		id := int(item)
+		ids = append(ids, id)
+	}
 
-	query := svc.Client.User.DeleteOneID(int(req.Msg.Value))
-	if err := svc.RunHooks(ctx, runtime.ActionDelete, req, query); err != nil {
+	query := svc.Client.User.Query().
+		Where(user.IDIn(ids...))
+	if err := svc.RunHooks(ctx, runtime.ActionBatchGet, req, query); err != nil {
 		return nil, err
 	}
 
-	if err := query.Exec(ctx); err != nil {
+	entities, err := query.All(ctx)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	found := make(map[int]*ent.User, len(entities))
+	for _, e := range entities {
+		found[e.ID] = e
+	}
+
+	// Entities are returned in the order of the request, IDs that weren't found are reported as missing.
+	msg := &entpb.BatchGetUserResponse{}
+	ordered := make([]*ent.User, 0, len(ids))
+	for i, id := range ids {
+		if e, ok := found[id]; ok {
+			ordered = append(ordered, e)
+		} else {
+			msg.MissingIds = append(msg.MissingIds, req.Msg.Ids[i])
+		}
+	}
+	msg.Items, err = ToProtoUserList(ordered)
+	if err != nil {
 		return nil, wrapError(err)
 	}
 
-	res := connect.NewResponse(&emptypb.Empty{})
-	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterDelete, req, res); err != nil {
+	res := connect.NewResponse(msg)
+	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterBatchGet, req, res); err != nil {
 		return nil, err
 	}
 	return res, nil
 
 }
 
-// List implements UserServiceHandlerServer.List
-func (svc *UserServiceHandler) List(ctx context.Context, req *connect.Request[entpb.ListUserRequest]) (*connect.Response[entpb.ListUserResponse], error) {
+// BatchUpdate implements UserServiceHandlerServer.BatchUpdate
+func (svc *UserServiceHandler) BatchUpdate(ctx context.Context, req *connect.Request[entpb.BatchUpdateUserRequest]) (*connect.Response[entpb.BatchUpdateUserResponse], error) {
 
-	query, totalQuery, err := svc.BuildListQuery(ctx, req)
+	if len(req.Msg.Requests) > 1000 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("batch size exceeds 1000"))
+	}
 
+	// Each item runs the hooks of the Update method, the whole batch is rolled back if any of them fails.
+	var msg *entpb.BatchUpdateUserResponse
+	err := runtime.RunInTx(ctx, svc.RetryPolicy(), svc.Client.Tx, func(ctx context.Context, tx *ent.Tx) error {
+		ctx = ent.NewTxContext(ctx, tx)
+		msg = &entpb.BatchUpdateUserResponse{}
+		for _, item := range req.Msg.Requests {
+			m, err := svc.updateBuilder(tx.Client(), item)
+			if err != nil {
+				return err
+			}
+			itemReq := runtime.NewItemRequest(req, item)
+			if err := svc.RunHooks(ctx, runtime.ActionUpdate, itemReq, m); err != nil {
+				return err
+			}
+			itemRes, err := runtime.WrapResult(WrapProtoUser(m.Save(ctx)))
+			if err != nil {
+				return err
+			}
+			if err := svc.RunHooksAfter(ctx, runtime.ActionAfterUpdate, itemReq, itemRes); err != nil {
+				return err
+			}
+			msg.Items = append(msg.Items, itemRes.Msg)
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, wrapError(err)
 	}
 
-	items, err := WrapProtoUserList(query.All(ctx))
+	return connect.NewResponse(msg), nil
+
+}
+
+// BatchDelete implements UserServiceHandlerServer.BatchDelete
+func (svc *UserServiceHandler) BatchDelete(ctx context.Context, req *connect.Request[entpb.BatchDeleteUserRequest]) (*connect.Response[emptypb.Empty], error) {
+
+	if len(req.Msg.Ids) > 1000 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("batch size exceeds 1000"))
+	}
+	ids := make([]int, 0, len(req.Msg.Ids))
+	for _, item := range req.Msg.Ids {
+		id := int(item)
+		ids = append(ids, id)
+	}
+
+	// Each ID runs the hooks of the Delete method, the whole batch is rolled back if any of them fails.
+	err := runtime.RunInTx(ctx, svc.RetryPolicy(), svc.Client.Tx, func(ctx context.Context, tx *ent.Tx) error {
+		ctx = ent.NewTxContext(ctx, tx)
+		for i, id := range ids {
+			itemReq := runtime.NewItemRequest(req, &entpb.DeleteUserRequest{
+				Id: req.Msg.Ids[i],
+			})
+			query := tx.User.DeleteOneID(id)
+			if err := svc.RunHooks(ctx, runtime.ActionDelete, itemReq, query); err != nil {
+				return err
+			}
+			if err := query.Exec(ctx); err != nil {
+				return err
+			}
+			itemRes := connect.NewResponse(&emptypb.Empty{})
+			if err := svc.RunHooksAfter(ctx, runtime.ActionAfterDelete, itemReq, itemRes); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, wrapError(err)
 	}
-	total, err := totalQuery.Count(ctx)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+
+}
+
+// StreamList implements UserServiceHandlerServer.StreamList
+func (svc *UserServiceHandler) StreamList(ctx context.Context, req *connect.Request[entpb.ListUserRequest], stream *connect.ServerStream[entpb.StreamListUserResponse]) error {
+
+	if req.Msg.Offset != nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("offset is not supported by StreamList, use page_token"))
+	}
+	if req.Msg.NoLimit {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("no_limit is not supported by StreamList, use page_size"))
+	}
+	// BuildListQuery rejects the orders that don't support page tokens, which the batches following the first one are
+	// read after.
+	query, _, err := svc.BuildListQuery(ctx, req)
 	if err != nil {
-		return nil, wrapError(err)
+		return wrapError(err)
+	}
+	orderTerms, err := svc.listOrder(req)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	res := connect.NewResponse(&entpb.ListUserResponse{
-		Items: items,
-		Total: int32(total),
+	// The entities are read in batches of page_size, each batch starting after the last entity of the previous one,
+	// and sent as one message.
+	batchSize := svc.listLimit(req)
+	batch := query.Clone().Limit(batchSize)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		entities, err := batch.All(ctx)
+		if err != nil {
+			return wrapError(err)
+		}
+		if len(entities) == 0 {
+			return nil
+		}
+		items, err := ToProtoUserList(entities)
+		if err != nil {
+			return wrapError(err)
+		}
+		for _, item := range items {
+			runtime.ApplyReadMask(item, req.Msg.ReadMask)
+		}
+		msg := &entpb.StreamListUserResponse{
+			Items: items,
+		}
+		if err := svc.RunHooksAfter(ctx, runtime.ActionAfterStreamList, req, msg); err != nil {
+			return err
+		}
+		if err := stream.Send(msg); err != nil {
+			return err
+		}
+		if len(entities) < batchSize {
+			return nil
+		}
+
+		pageToken, err := svc.listPageToken(orderTerms, entities[len(entities)-1])
+		if err != nil {
+			return wrapError(err)
+		}
+		afterCursor, err := svc.listPageTokenPredicate(pageToken, orderTerms)
+		if err != nil {
+			return wrapError(err)
+		}
+		batch = query.Clone().Where(afterCursor).Limit(batchSize)
+	}
+
+}
+
+// Count implements UserServiceHandlerServer.Count
+func (svc *UserServiceHandler) Count(ctx context.Context, req *connect.Request[entpb.CountUserRequest]) (*connect.Response[entpb.CountUserResponse], error) {
+
+	query := svc.Client.User.Query()
+	if req.Msg.Filter != nil {
+		preds, err := svc.listFilter(req.Msg.Filter, 0)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(preds...)
+		if query, err = svc.applyExtraFilters(ctx, req.Msg.Filter, query); err != nil {
+			return nil, err
+		}
+	}
+	if err := svc.RunHooks(ctx, runtime.ActionCount, req, query); err != nil {
+		return nil, err
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	res := connect.NewResponse(&entpb.CountUserResponse{
+		Count: int64(count),
 	})
-	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterList, req, res); err != nil {
+	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterCount, req, res); err != nil {
 		return nil, err
 	}
 	return res, nil
 
 }
 
-// List implements UserServiceHandlerServer.List
-func (svc *UserServiceHandler) BuildListQuery(ctx context.Context, req *connect.Request[entpb.ListUserRequest]) (*ent.UserQuery, *ent.UserQuery, error) {
+// Aggregate implements UserServiceHandlerServer.Aggregate
+func (svc *UserServiceHandler) Aggregate(ctx context.Context, req *connect.Request[entpb.AggregateUserRequest]) (*connect.Response[entpb.AggregateUserResponse], error) {
+
+	if len(req.Msg.Metrics) > 10 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("at most 10 metrics are allowed"))
+	}
+	if len(req.Msg.GroupBy) == 0 && len(req.Msg.Metrics) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("group_by or metrics is required"))
+	}
+	var columns, paths []string
+	for _, f := range req.Msg.GroupBy {
+		switch f {
+		case entpb.UserGroupField_USER_GROUP_FIELD_GENDER:
+			columns = append(columns, user.FieldGender)
+			paths = append(paths, "gender")
+		case entpb.UserGroupField_USER_GROUP_FIELD_GROUP_ID:
+			columns = append(columns, user.FieldGroupID)
+			paths = append(paths, "group_id")
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unknown group_by field %s", f))
+		}
+	}
+	fns := make([]ent.AggregateFunc, 0, len(req.Msg.Metrics))
+	for i, metric := range req.Msg.Metrics {
+		var column string
+		switch metric.GetField() {
+		case entpb.UserAggregateField_USER_AGGREGATE_FIELD_POINTS:
+			column = user.FieldPoints
+		case 0:
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unknown metric field %s", metric.GetField()))
+		}
+		var fn ent.AggregateFunc
+		switch metric.GetFunction() {
+		case entpb.UserAggregateFunction_USER_AGGREGATE_FUNCTION_COUNT:
+			if column != "" {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("%s metric doesn't take a field", metric.GetFunction()))
+			}
+			fn = ent.Count()
+		case entpb.UserAggregateFunction_USER_AGGREGATE_FUNCTION_SUM:
+			if column == "" {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("%s metric requires a field", metric.GetFunction()))
+			}
+			fn = ent.Sum(column)
+		case entpb.UserAggregateFunction_USER_AGGREGATE_FUNCTION_MIN:
+			if column == "" {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("%s metric requires a field", metric.GetFunction()))
+			}
+			fn = ent.Min(column)
+		case entpb.UserAggregateFunction_USER_AGGREGATE_FUNCTION_MAX:
+			if column == "" {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("%s metric requires a field", metric.GetFunction()))
+			}
+			fn = ent.Max(column)
+		case entpb.UserAggregateFunction_USER_AGGREGATE_FUNCTION_MEAN:
+			if column == "" {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("%s metric requires a field", metric.GetFunction()))
+			}
+			fn = ent.Mean(column)
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unknown metric function %s", metric.GetFunction()))
+		}
+		fns = append(fns, ent.As(fn, fmt.Sprintf("aggregate_%d", i)))
+	}
+	query := svc.Client.User.Query()
+	if req.Msg.Filter != nil {
+		preds, err := svc.listFilter(req.Msg.Filter, 0)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(preds...)
+		if query, err = svc.applyExtraFilters(ctx, req.Msg.Filter, query); err != nil {
+			return nil, err
+		}
+	}
+	// One more group than allowed is read to detect the requests exceeding the limit.
+	query = query.Limit(1000 + 1)
+	if len(columns) > 0 {
+		query = query.Order(ent.Asc(columns...))
+	}
+	if err := svc.RunHooks(ctx, runtime.ActionAggregate, req, query); err != nil {
+		return nil, err
+	}
+
+	// The grouped columns are scanned into the entity by name, and the metrics into the aggregate_<i> columns.
+	var rows []struct {
+		ent.User
+		Aggregate0 *float64 `sql:"aggregate_0"`
+		Aggregate1 *float64 `sql:"aggregate_1"`
+		Aggregate2 *float64 `sql:"aggregate_2"`
+		Aggregate3 *float64 `sql:"aggregate_3"`
+		Aggregate4 *float64 `sql:"aggregate_4"`
+		Aggregate5 *float64 `sql:"aggregate_5"`
+		Aggregate6 *float64 `sql:"aggregate_6"`
+		Aggregate7 *float64 `sql:"aggregate_7"`
+		Aggregate8 *float64 `sql:"aggregate_8"`
+		Aggregate9 *float64 `sql:"aggregate_9"`
+	}
+	var err error
+	if len(columns) > 0 {
+		err = query.GroupBy(columns[0], columns[1:]...).Aggregate(fns...).Scan(ctx, &rows)
+	} else {
+		err = query.Aggregate(fns...).Scan(ctx, &rows)
+	}
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if len(rows) > 1000 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("the entities fall into more than 1000 groups"))
+	}
+
+	msg := &entpb.AggregateUserResponse{}
+	for _, r := range rows {
+		group := &entpb.AggregateUserGroup{}
+		if len(columns) > 0 {
+			key, err := ToProtoUser(&r.User)
+			if err != nil {
+				return nil, wrapError(err)
+			}
+			runtime.ApplyReadMask(key, &fieldmaskpb.FieldMask{Paths: paths})
+			group.Key = key
+		}
+		values := []*float64{r.Aggregate0, r.Aggregate1, r.Aggregate2, r.Aggregate3, r.Aggregate4, r.Aggregate5, r.Aggregate6, r.Aggregate7, r.Aggregate8, r.Aggregate9}
+		for _, v := range values[:len(fns)] {
+			if v == nil {
+				group.Values = append(group.Values, structpb.NewNullValue())
+			} else {
+				group.Values = append(group.Values, structpb.NewNumberValue(*v))
+			}
+		}
+		msg.Groups = append(msg.Groups, group)
+	}
 
-	snake := gen.Funcs["snake"].(func(string) string)
+	res := connect.NewResponse(msg)
+	if err := svc.RunHooksAfter(ctx, runtime.ActionAfterAggregate, req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+
+}
+
+// BuildListQuery builds the queries of the List and StreamList methods, returning the query of the
+// entities and the query counting them.
+func (svc *UserServiceHandler) BuildListQuery(ctx context.Context, req *connect.Request[entpb.ListUserRequest]) (*ent.UserQuery, *ent.UserQuery, error) {
 
 	query := svc.Client.User.Query()
 	totalQuery := svc.Client.User.Query()
 
 	if !req.Msg.NoLimit {
-		if req.Msg.Limit != nil && req.Msg.Limit.Value > 0 {
-			query = query.Limit(int(req.Msg.Limit.Value))
-		} else { // If no limit, set default limit
-			query = query.Limit(10)
-		}
+		query = query.Limit(svc.listLimit(req))
 	}
 	if req.Msg.Offset != nil {
 		query = query.Offset(int(req.Msg.Offset.Value))
 	}
-	if req.Msg.Order != nil {
-		OrderFunc := ent.Asc
-		if req.Msg.Descending {
-			OrderFunc = ent.Desc
+	orderTerms, err := svc.listOrder(req)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	// Without offset, the following pages can only be read with page tokens.
+	if req.Msg.Offset == nil && !req.Msg.NoLimit {
+		if err := svc.listCursorOrder(orderTerms); err != nil {
+			return nil, nil, err
 		}
-		query = query.Order(OrderFunc(snake(req.Msg.Order.Value)))
-	} else {
-		query = query.Order(ent.Asc("id"))
+	}
+	for _, term := range orderTerms {
+		if term.Descending {
+			query = query.Order(ent.Desc(term.Column))
+		} else {
+			query = query.Order(ent.Asc(term.Column))
+		}
+	}
+	if query, err = svc.loadEdges(query, req.Msg.With); err != nil {
+		return nil, nil, err
+	}
+	if req.Msg.ReadMask != nil {
+		columns, err := svc.readMaskColumns(req.Msg.ReadMask)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(columns) > 0 {
+			// The order columns are needed to build the next page token.
+			for _, term := range orderTerms {
+				columns = append(columns, term.Column)
+			}
+			query = query.Select(columns...).UserQuery
+		}
+	}
+	if req.Msg.PageToken != "" {
+		if req.Msg.Offset != nil {
+			return nil, nil, connect.NewError(connect.CodeInvalidArgument, errors.New("page_token and offset cannot be used together"))
+		}
+		afterCursor, err := svc.listPageTokenPredicate(req.Msg.PageToken, orderTerms)
+		if err != nil {
+			return nil, nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		query = query.Where(afterCursor)
 	}
 
 	if req.Msg.Filter != nil {
+		preds, err := svc.listFilter(req.Msg.Filter, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		query = query.Where(preds...)
+		totalQuery = totalQuery.Where(preds...)
+		if query, err = svc.applyExtraFilters(ctx, req.Msg.Filter, query); err != nil {
+			return nil, nil, err
+		}
+		if totalQuery, err = svc.applyExtraFilters(ctx, req.Msg.Filter, totalQuery); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := svc.RunHooks(ctx, runtime.ActionList, req, query); err != nil {
+		return nil, nil, err
+	}
+	if err := svc.RunHooks(ctx, runtime.ActionListCount, req, totalQuery); err != nil {
+		return nil, nil, err
+	}
 
-		if req.Msg.Filter.GetName() != nil {
-			filterName := req.Msg.Filter.GetName().GetValue()
-			query = query.Where(user.NameEQ(filterName))
-			totalQuery = totalQuery.Where(user.NameEQ(filterName))
+	return query, totalQuery, nil
+
+}
+
+// listFilter returns the predicates selecting the entities matching a List filter. The and, or and not sub-filters
+// are applied recursively, up to the maximum filter depth of the service.
+func (svc *UserServiceHandler) listFilter(f *entpb.ListUserFilter, depth int) ([]predicate.User, error) {
+	if depth > svc.MaxFilterDepth() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("filters can't be nested more than %d levels deep", svc.MaxFilterDepth()))
+	}
+	preds := []predicate.User{}
+	if depth > 0 && f.GetPrefix() != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("prefix can't be used in sub-filters"))
+	}
+
+	if f.GetName() != nil {
+		filterName := f.GetName().GetValue()
+		preds = append(preds, user.NameEQ(filterName))
+	}
+
+	if f.GetNameContains() != nil {
+		filterNameContains := f.GetNameContains().GetValue()
+		preds = append(preds, user.NameContains(filterNameContains))
+	}
+
+	if f.GetNameHasPrefix() != nil {
+		filterNameHasPrefix := f.GetNameHasPrefix().GetValue()
+		preds = append(preds, user.NameHasPrefix(filterNameHasPrefix))
+	}
+
+	if f.GetNameContainsFold() != nil {
+		filterNameContainsFold := f.GetNameContainsFold().GetValue()
+		preds = append(preds, user.NameContainsFold(filterNameContainsFold))
+	}
+
+	if f.GetNameIn() != nil {
+		filterNameIns := []string{}
+		for _, item := range f.GetNameIn() {
+			filterNameIn := item
+			filterNameIns = append(filterNameIns, filterNameIn)
 		}
+		preds = append(preds, user.NameIn(filterNameIns...))
+	}
 
-		if req.Msg.Filter.GetNameContains() != nil {
-			filterNameContains := req.Msg.Filter.GetNameContains().GetValue()
-			query = query.Where(user.NameContains(filterNameContains))
-			totalQuery = totalQuery.Where(user.NameContains(filterNameContains))
+	if f.GetDescriptionIsNil() != nil {
+		if f.GetDescriptionIsNil().GetValue() {
+			preds = append(preds, user.DescriptionIsNil())
+		} else {
+			preds = append(preds, user.DescriptionNotNil())
 		}
+	}
 
-		if req.Msg.Filter.GetNameIn() != nil {
-			filterNameIns := []string{}
-			for _, item := range req.Msg.Filter.GetNameIn() {
-				filterNameIn := item
-				filterNameIns = append(filterNameIns, filterNameIn)
-			}
-			query = query.Where(user.NameIn(filterNameIns...))
-			totalQuery = totalQuery.Where(user.NameIn(filterNameIns...))
+	if f.GetGender() != nil {
+		filterGender := toEntUser_Gender(f.GetGender().GetValue())
+		preds = append(preds, user.GenderEQ(filterGender))
+	}
+
+	if f.GetGenderIn() != nil {
+		filterGenderIns := []user.Gender{}
+		for _, item := range f.GetGenderIn() {
+			filterGenderIn := toEntUser_Gender(item)
+			filterGenderIns = append(filterGenderIns, filterGenderIn)
 		}
+		preds = append(preds, user.GenderIn(filterGenderIns...))
+	}
 
-		filterGender := toEntUser_Gender(req.Msg.Filter.GetGender().GetValue())
-		query = query.Where(user.GenderEQ(filterGender))
-		totalQuery = totalQuery.Where(user.GenderEQ(filterGender))
+	if f.GetGenderNeq() != nil {
+		filterGenderNeq := toEntUser_Gender(f.GetGenderNeq().GetValue())
+		preds = append(preds, user.GenderNEQ(filterGenderNeq))
+	}
 
-		if req.Msg.Filter.GetGenderIn() != nil {
-			filterGenderIns := []user.Gender{}
-			for _, item := range req.Msg.Filter.GetGenderIn() {
-				filterGenderIn := toEntUser_Gender(item)
-				filterGenderIns = append(filterGenderIns, filterGenderIn)
-			}
-			query = query.Where(user.GenderIn(filterGenderIns...))
-			totalQuery = totalQuery.Where(user.GenderIn(filterGenderIns...))
+	if f.GetGenderNotIn() != nil {
+		filterGenderNotIns := []user.Gender{}
+		for _, item := range f.GetGenderNotIn() {
+			filterGenderNotIn := toEntUser_Gender(item)
+			filterGenderNotIns = append(filterGenderNotIns, filterGenderNotIn)
+		}
+		preds = append(preds, user.GenderNotIn(filterGenderNotIns...))
+	}
+
+	if f.GetCreatedAt() != nil {
+		filterCreatedAt := runtime.ExtractTime(f.GetCreatedAt())
+		preds = append(preds, user.CreatedAtEQ(filterCreatedAt))
+	}
+
+	if f.GetCreatedAtIn() != nil {
+		filterCreatedAtIns := []time.Time{}
+		for _, item := range f.GetCreatedAtIn() {
+			filterCreatedAtIn := runtime.ExtractTime(item)
+			filterCreatedAtIns = append(filterCreatedAtIns, filterCreatedAtIn)
 		}
+		preds = append(preds, user.CreatedAtIn(filterCreatedAtIns...))
+	}
+
+	if f.GetCreatedAtGte() != nil {
+		filterCreatedAtGte := runtime.ExtractTime(f.GetCreatedAtGte())
+		preds = append(preds, user.CreatedAtGTE(filterCreatedAtGte))
+	}
+
+	if f.GetCreatedAtLte() != nil {
+		filterCreatedAtLte := runtime.ExtractTime(f.GetCreatedAtLte())
+		preds = append(preds, user.CreatedAtLTE(filterCreatedAtLte))
+	}
 
-		if req.Msg.Filter.GetCreatedAt() != nil {
-			filterCreatedAt := runtime.ExtractTime(req.Msg.Filter.GetCreatedAt())
-			query = query.Where(user.CreatedAtEQ(filterCreatedAt))
-			totalQuery = totalQuery.Where(user.CreatedAtEQ(filterCreatedAt))
+	if f.GetHasGroup() != nil {
+		if f.GetHasGroup().GetValue() {
+			preds = append(preds, user.HasGroupWith(group.DeletedAtIsNil()))
+		} else {
+			preds = append(preds, user.Not(user.HasGroupWith(group.DeletedAtIsNil())))
 		}
+	}
 
-		if req.Msg.Filter.GetCreatedAtIn() != nil {
-			filterCreatedAtIns := []time.Time{}
-			for _, item := range req.Msg.Filter.GetCreatedAtIn() {
-				filterCreatedAtIn := runtime.ExtractTime(item)
-				filterCreatedAtIns = append(filterCreatedAtIns, filterCreatedAtIn)
+	if f.GetGroupIdIn() != nil {
+		filterGroupIdIns := []int{}
+		for _, item := range f.GetGroupIdIn() {
+			filterGroupIdIn := int(item)
+			filterGroupIdIns = append(filterGroupIdIns, filterGroupIdIn)
+		}
+		preds = append(preds, user.GroupIDIn(filterGroupIdIns...))
+	}
+	if len(f.And) > 0 {
+		andPreds := []predicate.User{}
+		for _, sub := range f.And {
+			subPreds, err := svc.listFilter(sub, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			andPreds = append(andPreds, subPreds...)
+		}
+		if len(andPreds) > 0 {
+			preds = append(preds, user.And(andPreds...))
+		}
+	}
+	if len(f.Or) > 0 {
+		orPreds := []predicate.User{}
+		for _, sub := range f.Or {
+			subPreds, err := svc.listFilter(sub, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			if len(subPreds) == 0 {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("or sub-filters can't be empty"))
 			}
-			query = query.Where(user.CreatedAtIn(filterCreatedAtIns...))
-			totalQuery = totalQuery.Where(user.CreatedAtIn(filterCreatedAtIns...))
+			orPreds = append(orPreds, user.And(subPreds...))
+		}
+		preds = append(preds, user.Or(orPreds...))
+	}
+	if f.Not != nil {
+		subPreds, err := svc.listFilter(f.Not, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		if len(subPreds) == 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("not sub-filter can't be empty"))
 		}
+		preds = append(preds, user.Not(user.And(subPreds...)))
 	}
+	return preds, nil
+}
 
-	if err := svc.RunHooks(ctx, runtime.ActionList, req, query); err != nil {
-		return nil, nil, err
+// applyExtraFilters applies the extra fields of a List filter to a query with the UserExtraFilterApplier
+// of the service.
+func (svc *UserServiceHandler) applyExtraFilters(ctx context.Context, f *entpb.ListUserFilter, query *ent.UserQuery) (*ent.UserQuery, error) {
+	if svc.ExtraFilters == nil {
+		if f.GetPrefix() != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.New("UserServiceHandler has no UserExtraFilterApplier, build it with NewUserServiceHandler"))
+		}
+		return query, nil
 	}
-	if err := svc.RunHooks(ctx, runtime.ActionListCount, req, totalQuery); err != nil {
-		return nil, nil, err
+	var err error
+	if f.GetPrefix() != nil {
+		filterPrefix := f.GetPrefix().GetValue()
+		if query, err = svc.ExtraFilters.ApplyPrefix(ctx, query, filterPrefix); err != nil {
+			return nil, err
+		}
 	}
+	return query, nil
+}
 
-	return query, totalQuery, nil
+// listLimit returns the maximum number of entities returned by a List call.
+func (svc *UserServiceHandler) listLimit(req *connect.Request[entpb.ListUserRequest]) int {
+	if req.Msg.Limit != nil && req.Msg.Limit.Value > 0 {
+		return int(req.Msg.Limit.Value)
+	}
+	return 10 // If no limit, set default limit
+}
+
+// listOrder returns the order of a List call. The tie-breaker is appended to the order requested by the client, or
+// to the default order if the client didn't request any.
+func (svc *UserServiceHandler) listOrder(req *connect.Request[entpb.ListUserRequest]) ([]runtime.OrderTerm, error) {
+	orderTerms := make([]runtime.OrderTerm, 0, len(req.Msg.Order)+1)
+	for _, o := range req.Msg.Order {
+		var column string
+		switch o.GetField() {
+		case entpb.UserOrderField_USER_ORDER_FIELD_ID:
+			column = user.FieldID
+		case entpb.UserOrderField_USER_ORDER_FIELD_NAME:
+			column = user.FieldName
+		case entpb.UserOrderField_USER_ORDER_FIELD_CREATED_AT:
+			column = user.FieldCreatedAt
+		default:
+			return nil, fmt.Errorf("unknown order field %s", o.GetField())
+		}
+		orderTerms = append(orderTerms, runtime.OrderTerm{Column: column, Descending: o.GetDescending()})
+	}
+	if len(orderTerms) == 0 {
+		orderTerms = append(orderTerms,
+			runtime.OrderTerm{Column: user.FieldCreatedAt, Descending: true},
+		)
+	}
+	return runtime.NormalizeOrder(orderTerms, user.FieldID)
+}
+
+// listCursorValue returns the value of the column of the given entity. It reports false if the column doesn't
+// support keyset pagination.
+func (svc *UserServiceHandler) listCursorValue(column string, e *ent.User) (any, bool) {
+	switch column {
+	case user.FieldID:
+		return e.ID, true
+	case user.FieldName:
+		return e.Name, true
+	case user.FieldCreatedAt:
+		return e.CreatedAt, true
+	default:
+		return nil, false
+	}
+}
+
+// listCursorDecode decodes the JSON encoded value of the column stored in a page token.
+func (svc *UserServiceHandler) listCursorDecode(column string, raw json.RawMessage) (any, error) {
+	var (
+		e   ent.User
+		err error
+	)
+	switch column {
+	case user.FieldID:
+		err = json.Unmarshal(raw, &e.ID)
+		return e.ID, err
+	case user.FieldName:
+		err = json.Unmarshal(raw, &e.Name)
+		return e.Name, err
+	case user.FieldCreatedAt:
+		err = json.Unmarshal(raw, &e.CreatedAt)
+		return e.CreatedAt, err
+	default:
+		return nil, fmt.Errorf("ordering by %q does not support page tokens", column)
+	}
+}
+
+// listCursorOrder checks the columns of the order support keyset pagination, which the page tokens rely on.
+func (svc *UserServiceHandler) listCursorOrder(orderTerms []runtime.OrderTerm) error {
+	for _, term := range orderTerms {
+		if _, ok := svc.listCursorValue(term.Column, &ent.User{}); !ok {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ordering by %q does not support page tokens, use offset", term.Column))
+		}
+	}
+	return nil
+}
 
+// listPageToken returns the token of the page following the given entity. The order must pass listCursorOrder.
+func (svc *UserServiceHandler) listPageToken(orderTerms []runtime.OrderTerm, lastEntity *ent.User) (string, error) {
+	cursorValues := make([]any, len(orderTerms))
+	for i, term := range orderTerms {
+		v, ok := svc.listCursorValue(term.Column, lastEntity)
+		if !ok {
+			return "", fmt.Errorf("ordering by %q does not support page tokens", term.Column)
+		}
+		cursorValues[i] = v
+	}
+	return runtime.EncodePageToken(orderTerms, cursorValues)
 }
 
-func (svc *UserServiceHandler) createBuilder(user *entpb.User) (*ent.UserCreate, error) {
-	m := svc.Client.User.Create()
+// listPageTokenPredicate returns the predicate selecting the entities following the position of a page token.
+func (svc *UserServiceHandler) listPageTokenPredicate(rawToken string, orderTerms []runtime.OrderTerm) (predicate.User, error) {
+	pageToken, err := runtime.DecodePageToken(rawToken, orderTerms)
+	if err != nil {
+		return nil, err
+	}
+	cursorValues := make([]any, len(orderTerms))
+	for i, term := range orderTerms {
+		if cursorValues[i], err = svc.listCursorDecode(term.Column, pageToken.Values[i]); err != nil {
+			return nil, fmt.Errorf("%w: %w", runtime.ErrInvalidPageToken, err)
+		}
+	}
+	return predicate.User(runtime.KeysetPredicate(orderTerms, cursorValues)), nil
+}
+
+// loadEdges eager-loads the edges selected by a Get or List request, or the default edges of the service if the
+// request selects none.
+func (svc *UserServiceHandler) loadEdges(query *ent.UserQuery, edges []entpb.UserEdge) (*ent.UserQuery, error) {
+	if len(edges) == 0 {
+		edges = []entpb.UserEdge{
+			entpb.UserEdge_USER_EDGE_GROUP,
+		}
+	}
+	for _, e := range edges {
+		switch e {
+		case entpb.UserEdge_USER_EDGE_GROUP:
+			query = query.WithGroup(func(q *ent.GroupQuery) {
+				q.Where(group.DeletedAtIsNil())
+				q.WithUsers()
+			})
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown edge %s", e))
+		}
+	}
+	return query, nil
+}
+
+// readMaskColumns returns the columns selected by the read mask of a Get or List request, or nil to select all of
+// them. Paths that aren't fields of User are rejected.
+func (svc *UserServiceHandler) readMaskColumns(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	columns := make([]string, 0, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "*":
+			return nil, nil
+		case "created_at":
+			columns = append(columns, user.FieldCreatedAt)
+		case "description":
+			columns = append(columns, user.FieldDescription)
+		case "gender":
+			columns = append(columns, user.FieldGender)
+		case "group_id":
+			columns = append(columns, user.FieldGroupID)
+		case "id":
+			columns = append(columns, user.FieldID)
+		case "name":
+			columns = append(columns, user.FieldName)
+		case "points":
+			columns = append(columns, user.FieldPoints)
+		case "preferences":
+			columns = append(columns, user.FieldPreferences)
+		case "group":
+			columns = append(columns, user.FieldGroupID)
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown read_mask path %q", path))
+		}
+	}
+	return columns, nil
+}
+
+func (svc *UserServiceHandler) createBuilder(client *ent.Client, user *entpb.User) (*ent.UserCreate, error) {
+	m := client.User.Create()
 	userCreatedAt := runtime.ExtractTime(user.GetCreatedAt())
 	m.SetCreatedAt(userCreatedAt)
 	if user.GetDescription() != nil {
@@ -285,6 +1077,8 @@ func (svc *UserServiceHandler) createBuilder(user *entpb.User) (*ent.UserCreate,
 	}
 	userName := user.GetName()
 	m.SetName(userName)
+	userPoints := int(user.GetPoints())
+	m.SetPoints(userPoints)
 	if user.GetPreferences() != nil {
 		var userPreferencesTmpObj ent.User
 		userPreferences := userPreferencesTmpObj.Preferences
@@ -299,3 +1093,65 @@ func (svc *UserServiceHandler) createBuilder(user *entpb.User) (*ent.UserCreate,
 	}
 	return m, nil
 }
+
+func (svc *UserServiceHandler) updateBuilder(client *ent.Client, user *entpb.UpdateUserRequest) (*ent.UserUpdateOne, error) {
+	userID := int(user.GetId())
+	m := client.User.UpdateOneID(userID)
+	updatePaths, err := runtime.UpdateMaskPaths(user.GetUpdateMask(), user, []string{"group_id", "group"}, "id", "update_mask", "clear_group")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if user.GetDescription() != nil && (updatePaths == nil || updatePaths["description"]) {
+		userDescription := user.GetDescription().GetValue()
+		m.SetDescription(userDescription)
+	} else if updatePaths["description"] {
+		m.ClearDescription()
+	}
+	if user.GetGender() != nil && (updatePaths == nil || updatePaths["gender"]) {
+		userGender := toEntUser_Gender(user.GetGender().GetValue())
+		m.SetGender(userGender)
+	} else if updatePaths["gender"] {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("gender is required and can't be cleared"))
+	}
+	if user.GetGroupId() != nil && (updatePaths == nil || updatePaths["group_id"]) {
+		userGroupID := int(user.GetGroupId().GetValue())
+		m.SetGroupID(userGroupID)
+	} else if updatePaths["group_id"] {
+		m.ClearGroupID()
+	}
+	if user.GetName() != nil && (updatePaths == nil || updatePaths["name"]) {
+		userName := user.GetName().GetValue()
+		m.SetName(userName)
+	} else if updatePaths["name"] {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required and can't be cleared"))
+	}
+	if user.GetPoints() != nil && (updatePaths == nil || updatePaths["points"]) {
+		userPoints := int(user.GetPoints().GetValue())
+		m.SetPoints(userPoints)
+	} else if updatePaths["points"] {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("points is required and can't be cleared"))
+	}
+	if user.GetPreferences() != nil && (updatePaths == nil || updatePaths["preferences"]) {
+		var userPreferencesTmpObj ent.User
+		userPreferences := userPreferencesTmpObj.Preferences
+		if err := runtime.FromStructPbValue(user.GetPreferences(), &userPreferences); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid argument: %s", err))
+		}
+		m.SetPreferences(userPreferences)
+	} else if updatePaths["preferences"] {
+		m.ClearPreferences()
+	}
+	if user.GetClearGroup() && user.GetGroup() != nil && (updatePaths == nil || updatePaths["group"]) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("clear_group can't be combined with group"))
+	}
+	if user.GetGroup() != nil && (updatePaths == nil || updatePaths["group"]) {
+		userGroup := int(user.GetGroup().GetId())
+		m.SetGroupID(userGroup)
+	} else if updatePaths["group"] {
+		m.ClearGroup()
+	}
+	if user.GetClearGroup() {
+		m.ClearGroup()
+	}
+	return m, nil
+}
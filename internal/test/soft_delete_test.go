@@ -0,0 +1,97 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	_ "github.com/mattn/go-sqlite3"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/yoshino-s/entproto/internal/test/ent/enttest"
+	"github.com/yoshino-s/entproto/internal/test/ent/schema"
+	"github.com/yoshino-s/entproto/internal/test/proto/entpb"
+	"github.com/yoshino-s/entproto/internal/test/proto/entpb/entpbservice"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestSoftDeleteField(t *testing.T) {
+	Convey("Given a group", t, func() {
+		client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+		defer client.Close()
+		svc := entpbservice.NewGroupServiceHandler(client, groupCustomMethods{})
+		ctx := context.Background()
+
+		g := client.Group.Create().SetName("admins").SetMetadata(schema.GroupMetadata{}).SetTags([]string{}).SaveX(ctx)
+		metadata, err := structpb.NewValue(map[string]any{"version": "2"})
+		So(err, ShouldBeNil)
+		tags, err := structpb.NewValue([]any{"ops"})
+		So(err, ShouldBeNil)
+		update := func(req *entpb.UpdateGroupRequest) error {
+			req.Id = int32(g.ID)
+			_, err := svc.Update(ctx, connect.NewRequest(req))
+			return err
+		}
+
+		Convey("Then the wildcard update mask leaves it out", func() {
+			err := update(&entpb.UpdateGroupRequest{
+				Name:       wrapperspb.String("owners"),
+				Metadata:   metadata,
+				Tags:       tags,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"*"}},
+			})
+			So(err, ShouldBeNil)
+			got := client.Group.GetX(ctx, g.ID)
+			So(got.Name, ShouldEqual, "owners")
+			So(got.Metadata.Version, ShouldEqual, "2")
+			So(got.DeletedAt, ShouldBeNil)
+		})
+		Convey("Then update masks can't list it", func() {
+			err := update(&entpb.UpdateGroupRequest{UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"deleted_at"}}})
+			So(connect.CodeOf(err), ShouldEqual, connect.CodeInvalidArgument)
+		})
+		Convey("Then updates can't set it", func() {
+			err := update(&entpb.UpdateGroupRequest{DeletedAt: timestamppb.Now()})
+			So(connect.CodeOf(err), ShouldEqual, connect.CodeInvalidArgument)
+			So(client.Group.GetX(ctx, g.ID).DeletedAt, ShouldBeNil)
+		})
+	})
+}
+
+func TestShowDeleted(t *testing.T) {
+	Convey("Given a soft-deleted group", t, func() {
+		client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+		defer client.Close()
+		svc := entpbservice.NewGroupServiceHandler(client, groupCustomMethods{})
+		ctx := context.Background()
+
+		g := client.Group.Create().SetName("admins").SetMetadata(schema.GroupMetadata{}).SetTags([]string{}).SaveX(ctx)
+		_, err := svc.Delete(ctx, connect.NewRequest(&entpb.DeleteGroupRequest{Id: int32(g.ID)}))
+		So(err, ShouldBeNil)
+		get := connect.NewRequest(&entpb.GetGroupRequest{Id: int32(g.ID), ShowDeleted: true})
+		list := connect.NewRequest(&entpb.ListGroupRequest{ShowDeleted: true})
+
+		Convey("Then show_deleted is rejected without a policy", func() {
+			_, err := svc.Get(ctx, get)
+			So(connect.CodeOf(err), ShouldEqual, connect.CodePermissionDenied)
+			_, err = svc.List(ctx, list)
+			So(connect.CodeOf(err), ShouldEqual, connect.CodePermissionDenied)
+		})
+		Convey("Then show_deleted is rejected for the callers the policy denies", func() {
+			svc.SetShowDeletedPolicy(func(context.Context) bool { return false })
+			_, err := svc.Get(ctx, get)
+			So(connect.CodeOf(err), ShouldEqual, connect.CodePermissionDenied)
+		})
+		Convey("Then the callers the policy allows read the deleted entities", func() {
+			svc.SetShowDeletedPolicy(func(context.Context) bool { return true })
+			res, err := svc.Get(ctx, get)
+			So(err, ShouldBeNil)
+			So(res.Msg.GetDeletedAt(), ShouldNotBeNil)
+			items, err := svc.List(ctx, list)
+			So(err, ShouldBeNil)
+			So(items.Msg.GetItems(), ShouldHaveLength, 1)
+		})
+	})
+}
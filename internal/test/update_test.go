@@ -0,0 +1,126 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	_ "github.com/mattn/go-sqlite3"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/yoshino-s/entproto/internal/test/ent"
+	"github.com/yoshino-s/entproto/internal/test/ent/enttest"
+	"github.com/yoshino-s/entproto/internal/test/ent/schema"
+	"github.com/yoshino-s/entproto/internal/test/ent/user"
+	"github.com/yoshino-s/entproto/internal/test/proto/entpb"
+	"github.com/yoshino-s/entproto/internal/test/proto/entpb/entpbservice"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type userExtraFilters struct {
+	entpbservice.UserExtraFilterApplier
+}
+
+func TestUpdateMask(t *testing.T) {
+	Convey("Given a user", t, func() {
+		client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+		defer client.Close()
+		svc := entpbservice.NewUserServiceHandler(client, userExtraFilters{})
+		ctx := context.Background()
+
+		u := client.User.Create().
+			SetName("alice").
+			SetDescription("admin").
+			SetGender(user.GenderFemale).
+			SetCreatedAt(time.Now()).
+			SaveX(ctx)
+		update := func(paths ...string) (*ent.User, error) {
+			_, err := svc.Update(ctx, connect.NewRequest(&entpb.UpdateUserRequest{
+				Id:         int32(u.ID),
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+			}))
+			return client.User.GetX(ctx, u.ID), err
+		}
+
+		Convey("Then listed optional fields left unset are cleared", func() {
+			got, err := update("description")
+			So(err, ShouldBeNil)
+			So(got.Description, ShouldBeEmpty)
+			So(got.Name, ShouldEqual, "alice")
+		})
+		Convey("Then listed required fields left unset are rejected", func() {
+			got, err := update("name")
+			So(connect.CodeOf(err), ShouldEqual, connect.CodeInvalidArgument)
+			So(got.Name, ShouldEqual, "alice")
+		})
+		Convey("Then the id and the mask itself can't be listed", func() {
+			_, err := update("id")
+			So(connect.CodeOf(err), ShouldEqual, connect.CodeInvalidArgument)
+			_, err = update("update_mask")
+			So(connect.CodeOf(err), ShouldEqual, connect.CodeInvalidArgument)
+		})
+	})
+}
+
+func TestUpdateMaskEdges(t *testing.T) {
+	Convey("Given a group and one of its users", t, func() {
+		client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+		defer client.Close()
+		users := entpbservice.NewUserServiceHandler(client, userExtraFilters{})
+		groups := entpbservice.NewGroupServiceHandler(client, groupCustomMethods{})
+		ctx := context.Background()
+
+		g := client.Group.Create().SetName("admins").SetMetadata(schema.GroupMetadata{}).SetTags([]string{}).SaveX(ctx)
+		alice := client.User.Create().
+			SetName("alice").
+			SetGender(user.GenderFemale).
+			SetCreatedAt(time.Now()).
+			SetGroup(g).
+			SaveX(ctx)
+		bob := client.User.Create().SetName("bob").SetGender(user.GenderMale).SetCreatedAt(time.Now()).SaveX(ctx)
+		wildcard := &fieldmaskpb.FieldMask{Paths: []string{"*"}}
+
+		Convey("Then the wildcard mask leaves the unset edges alone", func() {
+			_, err := users.Update(ctx, connect.NewRequest(&entpb.UpdateUserRequest{
+				Id:         int32(alice.ID),
+				Name:       wrapperspb.String("alice"),
+				Gender:     &entpb.UserGenderEnumValue{Value: entpb.User_GENDER_FEMALE},
+				Points:     wrapperspb.Int32(1),
+				UpdateMask: wildcard,
+			}))
+			So(err, ShouldBeNil)
+			So(client.User.QueryGroup(alice).OnlyIDX(ctx), ShouldEqual, g.ID)
+		})
+		Convey("Then the wildcard mask doesn't select the edge operations", func() {
+			_, err := groups.Update(ctx, connect.NewRequest(&entpb.UpdateGroupRequest{
+				Id:          int32(g.ID),
+				Name:        wrapperspb.String("admins"),
+				Metadata:    structpb.NewStructValue(&structpb.Struct{}),
+				Tags:        structpb.NewListValue(&structpb.ListValue{}),
+				AddUsersIds: []int32{int32(bob.ID)},
+				UpdateMask:  wildcard,
+			}))
+			So(err, ShouldBeNil)
+			So(client.Group.QueryUsers(g).IDsX(ctx), ShouldHaveLength, 2)
+		})
+		Convey("Then the edge operations ignore explicit masks, which can't list them", func() {
+			_, err := groups.Update(ctx, connect.NewRequest(&entpb.UpdateGroupRequest{
+				Id:             int32(g.ID),
+				RemoveUsersIds: []int32{int32(alice.ID)},
+				UpdateMask:     &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+				Name:           wrapperspb.String("owners"),
+			}))
+			So(err, ShouldBeNil)
+			So(client.Group.QueryUsers(g).IDsX(ctx), ShouldBeEmpty)
+
+			_, err = groups.Update(ctx, connect.NewRequest(&entpb.UpdateGroupRequest{
+				Id:          int32(g.ID),
+				AddUsersIds: []int32{int32(bob.ID)},
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"add_users_ids"}},
+			}))
+			So(connect.CodeOf(err), ShouldEqual, connect.CodeInvalidArgument)
+		})
+	})
+}
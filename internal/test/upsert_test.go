@@ -0,0 +1,53 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	_ "github.com/mattn/go-sqlite3"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/yoshino-s/entproto/internal/test/ent/enttest"
+	"github.com/yoshino-s/entproto/internal/test/ent/schema"
+	"github.com/yoshino-s/entproto/internal/test/proto/entpb"
+	"github.com/yoshino-s/entproto/internal/test/proto/entpb/entpbservice"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type groupCustomMethods struct {
+	entpbservice.GroupCustomMethods
+}
+
+func TestUpsertSoftDeleted(t *testing.T) {
+	Convey("Given a soft-deleted group", t, func() {
+		client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+		defer client.Close()
+		svc := entpbservice.NewGroupServiceHandler(client, groupCustomMethods{})
+		ctx := context.Background()
+
+		g := client.Group.Create().SetName("admins").SetMetadata(schema.GroupMetadata{}).SetTags([]string{}).SaveX(ctx)
+		_, err := svc.Delete(ctx, connect.NewRequest(&entpb.DeleteGroupRequest{Id: int32(g.ID)}))
+		So(err, ShouldBeNil)
+
+		Convey("Then updating it returns NotFound", func() {
+			_, err := svc.Update(ctx, connect.NewRequest(&entpb.UpdateGroupRequest{Id: int32(g.ID), Name: wrapperspb.String("owners")}))
+			So(connect.CodeOf(err), ShouldEqual, connect.CodeNotFound)
+		})
+
+		Convey("Then upserting it is rejected until it is undeleted", func() {
+			upsert := connect.NewRequest(&entpb.UpsertGroupRequest{
+				Group:      &entpb.Group{Name: "admins"},
+				OnConflict: entpb.GroupConflictTarget_GROUP_CONFLICT_TARGET_NAME,
+			})
+			_, err := svc.Upsert(ctx, upsert)
+			So(connect.CodeOf(err), ShouldEqual, connect.CodeFailedPrecondition)
+			So(client.Group.GetX(ctx, g.ID).DeletedAt, ShouldNotBeNil)
+
+			_, err = svc.Undelete(ctx, connect.NewRequest(&entpb.UndeleteGroupRequest{Id: int32(g.ID)}))
+			So(err, ShouldBeNil)
+			res, err := svc.Upsert(ctx, upsert)
+			So(err, ShouldBeNil)
+			So(res.Msg.GetId(), ShouldEqual, g.ID)
+		})
+	})
+}
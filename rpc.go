@@ -0,0 +1,135 @@
+package entproto
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/yoshino-s/entproto/convert"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var rpcNameRegexp = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+
+// RPC adds a custom method to the generated service, taking and returning the messages converted from the Go struct
+// types of input and output, see convert.Converter.GoTypeToDescriptorProto. The generated handler of the service
+// doesn't implement it, but delegates it to the <T>CustomMethods interface passed to its constructor.
+//
+//	type ArchiveGroupRequest struct {
+//		ID     int    `entproto:"1"`
+//		Reason string `entproto:"2"`
+//	}
+//
+//	entproto.Service(
+//		entproto.RPC("Archive", ArchiveGroupRequest{}, ArchiveGroupResponse{}),
+//	)
+func RPC(name string, input, output any) ServiceOption {
+	r := &rpc{Name: name}
+	// The Go types don't survive the serialization of the schema graph, so their messages are converted here.
+	converter := convert.New(&descriptorpb.FileDescriptorProto{})
+	inputMessage, err := converter.GoTypeToDescriptorProto(reflect.TypeOf(input))
+	if err != nil {
+		r.Err = err.Error()
+	}
+	outputMessage, err := converter.GoTypeToDescriptorProto(reflect.TypeOf(output))
+	if err != nil && r.Err == "" {
+		r.Err = err.Error()
+	}
+	if r.Err == "" {
+		r.Input, r.Output = inputMessage.GetName(), outputMessage.GetName()
+		for _, msg := range converter.MessageType {
+			b, err := protojson.Marshal(msg)
+			if err != nil {
+				r.Err = err.Error()
+				break
+			}
+			r.Messages = append(r.Messages, string(b))
+		}
+	}
+	return func(s *service) {
+		s.RPCs = append(s.RPCs, r)
+	}
+}
+
+type rpc struct {
+	Name   string
+	Input  string
+	Output string
+	// Messages holds the protojson encoding of the messages converted from the Go types of the method.
+	Messages []string
+	// Err holds the error converting the Go types, reported when generating the service.
+	Err string
+}
+
+// genRPCProtos returns the custom method declared with entproto.RPC.
+func (a *Adapter) genRPCProtos(genType *gen.Type, r *rpc) (methodResources, error) {
+	if r.Err != "" {
+		return methodResources{}, fmt.Errorf("entproto: invalid RPC %q of schema %q: %s", r.Name, genType.Name, r.Err)
+	}
+	if !rpcNameRegexp.MatchString(r.Name) {
+		return methodResources{}, fmt.Errorf("entproto: RPC %q of schema %q must be named in pascal case",
+			r.Name, genType.Name)
+	}
+	var messages []*descriptorpb.DescriptorProto
+	for _, m := range r.Messages {
+		msg := &descriptorpb.DescriptorProto{}
+		if err := protojson.Unmarshal([]byte(m), msg); err != nil {
+			return methodResources{}, fmt.Errorf("entproto: unable to decode message of RPC %q of schema %q: %w",
+				r.Name, genType.Name, err)
+		}
+		messages = append(messages, msg)
+	}
+	return methodResources{
+		methodDescriptor: &descriptorpb.MethodDescriptorProto{
+			Name:       strptr(r.Name),
+			InputType:  strptr(r.Input),
+			OutputType: strptr(r.Output),
+		},
+		messages: messages,
+	}, nil
+}
+
+// addRPCResources adds the custom methods of the service and their messages, which must not conflict with the
+// generated ones. The RPCs may share messages, as long as they are converted from the same Go types.
+func (a *Adapter) addRPCResources(genType *gen.Type, svcAnnotation *service, out *serviceResources) error {
+	methods := make(map[string]struct{})
+	for _, m := range out.svc.Method {
+		methods[m.GetName()] = struct{}{}
+	}
+	generated := make(map[string]struct{})
+	for _, msg := range out.svcMessages {
+		generated[msg.GetName()] = struct{}{}
+	}
+	custom := make(map[string]*descriptorpb.DescriptorProto)
+	for _, r := range svcAnnotation.RPCs {
+		resources, err := a.genRPCProtos(genType, r)
+		if err != nil {
+			return err
+		}
+		if _, ok := methods[r.Name]; ok {
+			return fmt.Errorf("entproto: RPC %q of schema %q conflicts with another method of the service",
+				r.Name, genType.Name)
+		}
+		methods[r.Name] = struct{}{}
+		for _, msg := range resources.messages {
+			if _, ok := generated[msg.GetName()]; ok || msg.GetName() == genType.Name {
+				return fmt.Errorf("entproto: message %q of RPC %q of schema %q conflicts with a generated message",
+					msg.GetName(), r.Name, genType.Name)
+			}
+			if prev, ok := custom[msg.GetName()]; ok {
+				if !proto.Equal(prev, msg) {
+					return fmt.Errorf("entproto: message %q of RPC %q of schema %q conflicts with another RPC",
+						msg.GetName(), r.Name, genType.Name)
+				}
+				continue
+			}
+			custom[msg.GetName()] = msg
+			out.svcMessages = append(out.svcMessages, msg)
+		}
+		out.svc.Method = append(out.svc.Method, resources.methodDescriptor)
+	}
+	return nil
+}
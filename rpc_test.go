@@ -0,0 +1,82 @@
+package entproto
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+type rpcTestRequest struct {
+	IDs     []int          `entproto:"1"`
+	Name    *string        `entproto:"2"`
+	Node    *rpcTestNode   `entproto:"3"`
+	At      time.Time      `entproto:"4"`
+	Skipped map[string]any `entproto:"-"`
+	private int
+}
+
+type rpcTestNode struct {
+	Children []*rpcTestNode `entproto:"1"`
+}
+
+type rpcTestResponse struct{}
+
+func rpcOf(opt ServiceOption) *rpc {
+	var s service
+	opt(&s)
+	So(s.RPCs, ShouldHaveLength, 1)
+	return s.RPCs[0]
+}
+
+func TestRPC(t *testing.T) {
+	Convey("Given Go struct types", t, func() {
+		r := rpcOf(RPC("Merge", rpcTestRequest{}, &rpcTestResponse{}))
+
+		Convey("Then their messages are converted once", func() {
+			So(r.Err, ShouldBeEmpty)
+			So(r.Input, ShouldEqual, "rpcTestRequest")
+			So(r.Output, ShouldEqual, "rpcTestResponse")
+			var msgs []*descriptorpb.DescriptorProto
+			for _, m := range r.Messages {
+				msg := &descriptorpb.DescriptorProto{}
+				So(protojson.Unmarshal([]byte(m), msg), ShouldBeNil)
+				msgs = append(msgs, msg)
+			}
+			So(msgs, ShouldHaveLength, 3)
+
+			fields := msgs[0].GetField()
+			So(fields, ShouldHaveLength, 4)
+			So(fields[0].GetName(), ShouldEqual, "ids")
+			So(fields[0].GetLabel(), ShouldEqual, descriptorpb.FieldDescriptorProto_LABEL_REPEATED)
+			So(fields[0].GetType(), ShouldEqual, descriptorpb.FieldDescriptorProto_TYPE_INT32)
+			So(fields[1].GetTypeName(), ShouldEqual, "google.protobuf.StringValue")
+			So(fields[2].GetTypeName(), ShouldEqual, "rpcTestNode")
+			So(fields[3].GetTypeName(), ShouldEqual, "google.protobuf.Timestamp")
+
+			So(msgs[1].GetName(), ShouldEqual, "rpcTestNode")
+			So(msgs[1].GetField()[0].GetTypeName(), ShouldEqual, "rpcTestNode")
+		})
+	})
+
+	Convey("Given unsupported Go types", t, func() {
+		Convey("Then non-struct types are rejected", func() {
+			So(rpcOf(RPC("Merge", "", rpcTestResponse{})).Err, ShouldContainSubstring, "not a named struct type")
+			So(rpcOf(RPC("Merge", rpcTestResponse{}, nil)).Err, ShouldContainSubstring, "not a named struct type")
+		})
+		Convey("Then fields without number are rejected", func() {
+			type request struct {
+				Name string
+			}
+			So(rpcOf(RPC("Merge", request{}, rpcTestResponse{})).Err, ShouldContainSubstring, "missing its number")
+		})
+		Convey("Then fields of unsupported types are rejected", func() {
+			type request struct {
+				Values map[string]int `entproto:"1"`
+			}
+			So(rpcOf(RPC("Merge", request{}, rpcTestResponse{})).Err, ShouldContainSubstring, "unsupported type")
+		})
+	})
+}
@@ -2,20 +2,83 @@ package runtime
 
 import (
 	"context"
+	"errors"
+
+	"connectrpc.com/connect"
 )
 
+// DefaultMaxFilterDepth is the default maximum nesting depth of the and, or and not sub-filters of List requests.
+const DefaultMaxFilterDepth = 5
+
+// ShowDeletedPolicy reports whether the caller of a request setting show_deleted may read the soft-deleted entities.
+type ShowDeletedPolicy func(ctx context.Context) bool
+
 type BaseService struct {
-	hooks      []Hook
-	afterHooks []HookAfter
+	hooks          []Hook
+	afterHooks     []HookAfter
+	maxFilterDepth int
+	retryPolicy    RetryPolicy
+	broker         Broker
+	showDeleted    ShowDeletedPolicy
 }
 
 func NewBaseService() *BaseService {
 	return &BaseService{
-		hooks:      []Hook{},
-		afterHooks: []HookAfter{},
+		hooks:          []Hook{},
+		afterHooks:     []HookAfter{},
+		maxFilterDepth: DefaultMaxFilterDepth,
+		retryPolicy:    DefaultRetryPolicy,
+		broker:         NewMemoryBroker(DefaultEventBuffer),
 	}
 }
 
+// SetMaxFilterDepth sets the maximum nesting depth of the and, or and not sub-filters of List requests. Requests
+// nesting filters deeper are rejected.
+func (svc *BaseService) SetMaxFilterDepth(depth int) {
+	svc.maxFilterDepth = depth
+}
+
+// MaxFilterDepth returns the maximum nesting depth of the sub-filters of List requests.
+func (svc *BaseService) MaxFilterDepth() int {
+	return svc.maxFilterDepth
+}
+
+// SetRetryPolicy sets how the transactions of the service are retried when they fail to serialize.
+func (svc *BaseService) SetRetryPolicy(policy RetryPolicy) {
+	svc.retryPolicy = policy
+}
+
+// RetryPolicy returns how the transactions of the service are retried when they fail to serialize.
+func (svc *BaseService) RetryPolicy() RetryPolicy {
+	return svc.retryPolicy
+}
+
+// SetBroker sets the broker the mutation methods of the service publish their changes to, and its Watch method
+// subscribes to. The services of a schema sharing a broker report the changes made through each other.
+func (svc *BaseService) SetBroker(broker Broker) {
+	svc.broker = broker
+}
+
+// Broker returns the broker of the Watch method of the service.
+func (svc *BaseService) Broker() Broker {
+	return svc.broker
+}
+
+// SetShowDeletedPolicy sets the policy deciding which callers may set the show_deleted field of the read requests of
+// soft-deleted schemas. Without a policy, the requests setting it are rejected.
+func (svc *BaseService) SetShowDeletedPolicy(policy ShowDeletedPolicy) {
+	svc.showDeleted = policy
+}
+
+// CheckShowDeleted returns a PermissionDenied error unless the show_deleted policy of the service allows the caller to
+// read the soft-deleted entities.
+func (svc *BaseService) CheckShowDeleted(ctx context.Context) error {
+	if svc.showDeleted == nil || !svc.showDeleted(ctx) {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("show_deleted is not allowed"))
+	}
+	return nil
+}
+
 func (svc *BaseService) AddHook(hook Hook) {
 	svc.hooks = append(svc.hooks, hook)
 }
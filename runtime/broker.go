@@ -0,0 +1,111 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// DefaultEventBuffer is the number of events the MemoryBroker of a new BaseService queues for each subscriber.
+const DefaultEventBuffer = 128
+
+// EventType is the kind of change an Event reports.
+type EventType string
+
+const (
+	EventCreated EventType = "created"
+	EventUpdated EventType = "updated"
+	EventDeleted EventType = "deleted"
+)
+
+// ErrSkipEvent is returned by the ActionAfterWatch hooks to skip the event they are called with, instead of ending the
+// Watch stream. Deletions of entities that aren't soft-deleted can't be matched against the filter of the request, and
+// are only scoped by such hooks.
+var ErrSkipEvent = errors.New("skip event")
+
+// Event reports a change of an entity to the Watch methods subscribed to its topic.
+type Event struct {
+	Type EventType
+	// Topic identifies the schema of the entity. The generated services use the full name of its protobuf message,
+	// exported as the <T>WatchTopic constant.
+	Topic string
+	// ID is the ent ID of the entity.
+	ID any
+}
+
+// Broker delivers the events published by the mutation methods of the services to their Watch methods. The
+// MemoryBroker delivers them within the process; implementations backed by an external bus let the Watch methods of
+// a replica report the changes made through the others.
+type Broker interface {
+	// Publish delivers event to the current subscribers of its topic. It is called once the change is committed, and
+	// must not block the mutation reporting it: delivery failures are handled by the broker.
+	Publish(ctx context.Context, event Event)
+	// Subscribe returns the events of topic published until ctx is done, when the channel is closed. The broker may
+	// also close the channel of a subscriber falling behind, which must then subscribe again.
+	Subscribe(ctx context.Context, topic string) (<-chan Event, error)
+}
+
+// MemoryBroker is a Broker delivering the events within the process.
+type MemoryBroker struct {
+	buffer int
+
+	mu          sync.Mutex
+	subscribers map[string]map[chan Event]struct{}
+}
+
+var _ Broker = (*MemoryBroker)(nil)
+
+// NewMemoryBroker returns a MemoryBroker queuing up to buffer events for each subscriber. The channel of a subscriber
+// is closed once its queue is full, instead of blocking the publishers.
+func NewMemoryBroker(buffer int) *MemoryBroker {
+	return &MemoryBroker{
+		buffer:      buffer,
+		subscribers: make(map[string]map[chan Event]struct{}),
+	}
+}
+
+func (b *MemoryBroker) Publish(_ context.Context, event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers[event.Topic] {
+		select {
+		case ch <- event:
+		default:
+			b.unsubscribe(event.Topic, ch)
+		}
+	}
+}
+
+func (b *MemoryBroker) Subscribe(ctx context.Context, topic string) (<-chan Event, error) {
+	ch := make(chan Event, b.buffer)
+	b.mu.Lock()
+	if b.subscribers[topic] == nil {
+		b.subscribers[topic] = make(map[chan Event]struct{})
+	}
+	b.subscribers[topic][ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.unsubscribe(topic, ch)
+	}()
+	return ch, nil
+}
+
+// unsubscribe closes the channel of a subscriber of topic, unless it is already closed. b.mu must be held.
+func (b *MemoryBroker) unsubscribe(topic string, ch chan Event) {
+	subscribers, ok := b.subscribers[topic]
+	if !ok {
+		return
+	}
+	if _, ok := subscribers[ch]; !ok {
+		return
+	}
+	delete(subscribers, ch)
+	if len(subscribers) == 0 {
+		delete(b.subscribers, topic)
+	}
+	close(ch)
+}
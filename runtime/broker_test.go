@@ -0,0 +1,38 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMemoryBroker(t *testing.T) {
+	Convey("Given a memory broker with a subscriber", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		broker := NewMemoryBroker(1)
+		events, err := broker.Subscribe(ctx, "entpb.User")
+		So(err, ShouldBeNil)
+
+		Convey("It delivers the events of the topic", func() {
+			broker.Publish(ctx, Event{Type: EventCreated, Topic: "entpb.Group", ID: 1})
+			broker.Publish(ctx, Event{Type: EventCreated, Topic: "entpb.User", ID: 2})
+			So(<-events, ShouldResemble, Event{Type: EventCreated, Topic: "entpb.User", ID: 2})
+		})
+
+		Convey("It closes the channel of a subscriber falling behind", func() {
+			broker.Publish(ctx, Event{Type: EventCreated, Topic: "entpb.User", ID: 1})
+			broker.Publish(ctx, Event{Type: EventUpdated, Topic: "entpb.User", ID: 1})
+			So(<-events, ShouldResemble, Event{Type: EventCreated, Topic: "entpb.User", ID: 1})
+			_, ok := <-events
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("It closes the channel once the context is done", func() {
+			cancel()
+			_, ok := <-events
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
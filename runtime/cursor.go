@@ -0,0 +1,108 @@
+package runtime
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// OrderTerm orders the results of a List call by a column.
+type OrderTerm struct {
+	Column     string `json:"c"`
+	Descending bool   `json:"d,omitempty"`
+}
+
+// NormalizeOrder rejects orders listing a column more than once, and appends the tie-breaker column unless the order
+// already includes it, so results are returned in a stable order.
+func NormalizeOrder(terms []OrderTerm, tieBreaker string) ([]OrderTerm, error) {
+	seen := make(map[string]struct{}, len(terms))
+	for _, term := range terms {
+		if _, ok := seen[term.Column]; ok {
+			return nil, fmt.Errorf("order field %q is listed more than once", term.Column)
+		}
+		seen[term.Column] = struct{}{}
+	}
+	if _, ok := seen[tieBreaker]; !ok {
+		terms = append(terms, OrderTerm{Column: tieBreaker})
+	}
+	return terms, nil
+}
+
+// PageToken is the decoded form of the opaque page_token returned by the generated List methods. It records the
+// order of the listing and the values of the order columns of the last returned entity, so the next page can be
+// selected with keyset predicates instead of an offset.
+type PageToken struct {
+	// Order is the order of the listing, including the tie-breaker column.
+	Order []OrderTerm `json:"o"`
+	// Values are the JSON encoded values of the order columns of the last entity.
+	Values []json.RawMessage `json:"v"`
+}
+
+// ErrInvalidPageToken is returned when a page token can't be decoded, or doesn't match the order of the request.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// EncodePageToken encodes the position of the last entity of a page into an opaque page token. values holds the
+// values of the order columns of the entity.
+func EncodePageToken(order []OrderTerm, values []any) (string, error) {
+	token := PageToken{
+		Order:  order,
+		Values: make([]json.RawMessage, len(values)),
+	}
+	for i, v := range values {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("encode page token value of %q: %w", order[i].Column, err)
+		}
+		token.Values[i] = b
+	}
+	b, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// DecodePageToken decodes a page token and verifies it was issued for the given order.
+func DecodePageToken(s string, order []OrderTerm) (*PageToken, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var token PageToken
+	if err := json.Unmarshal(b, &token); err != nil || len(token.Values) != len(token.Order) {
+		return nil, ErrInvalidPageToken
+	}
+	if !slices.Equal(token.Order, order) {
+		return nil, fmt.Errorf("%w: the token was issued for a different order", ErrInvalidPageToken)
+	}
+	return &token, nil
+}
+
+// KeysetPredicate selects the rows following the position given by values in a listing ordered by order. The last
+// term of the order must be a unique column.
+func KeysetPredicate(order []OrderTerm, values []any) func(*sql.Selector) {
+	preds := make([]func(*sql.Selector), 0, len(order))
+	for i, term := range order {
+		after := sql.FieldGT
+		if term.Descending {
+			after = sql.FieldLT
+		}
+		pred := after(term.Column, values[i])
+		if i > 0 {
+			eqs := make([]func(*sql.Selector), 0, i+1)
+			for j := range i {
+				eqs = append(eqs, sql.FieldEQ(order[j].Column, values[j]))
+			}
+			pred = sql.AndPredicates(append(eqs, pred)...)
+		}
+		preds = append(preds, pred)
+	}
+	if len(preds) == 1 {
+		return preds[0]
+	}
+	return sql.OrPredicates(preds...)
+}
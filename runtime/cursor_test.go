@@ -0,0 +1,103 @@
+package runtime
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect/sql"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func keysetQuery(order []OrderTerm, values []any) (string, []any) {
+	s := sql.Select("*").From(sql.Table("users"))
+	KeysetPredicate(order, values)(s)
+	return s.Query()
+}
+
+func TestNormalizeOrder(t *testing.T) {
+	Convey("Given an order", t, func() {
+		Convey("Then the tie-breaker is appended", func() {
+			terms, err := NormalizeOrder([]OrderTerm{{Column: "name", Descending: true}}, "id")
+			So(err, ShouldBeNil)
+			So(terms, ShouldResemble, []OrderTerm{{Column: "name", Descending: true}, {Column: "id"}})
+		})
+		Convey("Then the tie-breaker keeps its direction if the order includes it", func() {
+			terms, err := NormalizeOrder([]OrderTerm{{Column: "id", Descending: true}, {Column: "name"}}, "id")
+			So(err, ShouldBeNil)
+			So(terms, ShouldResemble, []OrderTerm{{Column: "id", Descending: true}, {Column: "name"}})
+		})
+		Convey("Then an empty order is ordered by the tie-breaker", func() {
+			terms, err := NormalizeOrder(nil, "id")
+			So(err, ShouldBeNil)
+			So(terms, ShouldResemble, []OrderTerm{{Column: "id"}})
+		})
+		Convey("Then columns listed twice are rejected", func() {
+			_, err := NormalizeOrder([]OrderTerm{{Column: "name"}, {Column: "name", Descending: true}}, "id")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestPageToken(t *testing.T) {
+	Convey("Given a page token", t, func() {
+		order := []OrderTerm{{Column: "name", Descending: true}, {Column: "id"}}
+		s, err := EncodePageToken(order, []any{"bob", 42})
+		So(err, ShouldBeNil)
+
+		Convey("Then it decodes for the same order", func() {
+			token, err := DecodePageToken(s, order)
+			So(err, ShouldBeNil)
+			So(token.Order, ShouldResemble, order)
+			So(token.Values, ShouldHaveLength, 2)
+			So(string(token.Values[0]), ShouldEqual, `"bob"`)
+			So(string(token.Values[1]), ShouldEqual, `42`)
+		})
+		Convey("Then it is rejected for another order", func() {
+			_, err := DecodePageToken(s, []OrderTerm{{Column: "name"}, {Column: "id"}})
+			So(errors.Is(err, ErrInvalidPageToken), ShouldBeTrue)
+			_, err = DecodePageToken(s, order[1:])
+			So(errors.Is(err, ErrInvalidPageToken), ShouldBeTrue)
+		})
+		Convey("Then tampered tokens are rejected", func() {
+			_, err := DecodePageToken(s[:len(s)-2]+"!!", order)
+			So(errors.Is(err, ErrInvalidPageToken), ShouldBeTrue)
+			_, err = DecodePageToken(base64.RawURLEncoding.EncodeToString([]byte(`{"o":[{"c":"id"}]`)), order)
+			So(errors.Is(err, ErrInvalidPageToken), ShouldBeTrue)
+			missing := base64.RawURLEncoding.EncodeToString([]byte(`{"o":[{"c":"name","d":true},{"c":"id"}],"v":["bob"]}`))
+			_, err = DecodePageToken(missing, order)
+			So(errors.Is(err, ErrInvalidPageToken), ShouldBeTrue)
+		})
+		Convey("Then empty tokens are rejected", func() {
+			_, err := DecodePageToken("", order)
+			So(errors.Is(err, ErrInvalidPageToken), ShouldBeTrue)
+		})
+	})
+}
+
+func TestKeysetPredicate(t *testing.T) {
+	Convey("Given an order by a single column", t, func() {
+		Convey("Then the rows after the value are selected", func() {
+			query, args := keysetQuery([]OrderTerm{{Column: "id"}}, []any{42})
+			So(query, ShouldEqual, "SELECT * FROM `users` WHERE `users`.`id` > ?")
+			So(args, ShouldResemble, []any{42})
+		})
+		Convey("Then descending orders select the rows before the value", func() {
+			query, _ := keysetQuery([]OrderTerm{{Column: "id", Descending: true}}, []any{42})
+			So(query, ShouldEqual, "SELECT * FROM `users` WHERE `users`.`id` < ?")
+		})
+	})
+
+	Convey("Given an order by several columns", t, func() {
+		Convey("Then the rows after the position are selected column by column", func() {
+			query, args := keysetQuery(
+				[]OrderTerm{{Column: "name", Descending: true}, {Column: "created_at"}, {Column: "id"}},
+				[]any{"bob", 7, 42},
+			)
+			So(query, ShouldEqual, "SELECT * FROM `users` WHERE `users`.`name` < ?"+
+				" OR (`users`.`name` = ? AND `users`.`created_at` > ?)"+
+				" OR (`users`.`name` = ? AND `users`.`created_at` = ? AND `users`.`id` > ?)")
+			So(args, ShouldResemble, []any{"bob", "bob", 7, "bob", 7, 42})
+		})
+	})
+}
@@ -5,12 +5,19 @@ import "context"
 type Action string
 
 const (
-	ActionGet       Action = "get"
-	ActionDelete    Action = "delete"
-	ActionCreate    Action = "create"
-	ActionUpdate    Action = "update"
-	ActionList      Action = "list"
-	ActionListCount Action = "list_count"
+	ActionGet         Action = "get"
+	ActionDelete      Action = "delete"
+	ActionCreate      Action = "create"
+	ActionUpdate      Action = "update"
+	ActionList        Action = "list"
+	ActionListCount   Action = "list_count"
+	ActionBatchCreate Action = "batch_create"
+	ActionBatchGet    Action = "batch_get"
+	ActionUpsert      Action = "upsert"
+	ActionUndelete    Action = "undelete"
+	ActionWatch       Action = "watch"
+	ActionCount       Action = "count"
+	ActionAggregate   Action = "aggregate"
 )
 
 type Hook interface {
@@ -26,11 +33,19 @@ func (f HookFunc) Hook(ctx context.Context, action Action, request any, query an
 type ActionAfter string
 
 const (
-	ActionAfterGet    ActionAfter = "after_get"
-	ActionAfterDelete ActionAfter = "after_delete"
-	ActionAfterCreate ActionAfter = "after_create"
-	ActionAfterUpdate ActionAfter = "after_update"
-	ActionAfterList   ActionAfter = "after_list"
+	ActionAfterGet         ActionAfter = "after_get"
+	ActionAfterDelete      ActionAfter = "after_delete"
+	ActionAfterCreate      ActionAfter = "after_create"
+	ActionAfterUpdate      ActionAfter = "after_update"
+	ActionAfterList        ActionAfter = "after_list"
+	ActionAfterBatchCreate ActionAfter = "after_batch_create"
+	ActionAfterBatchGet    ActionAfter = "after_batch_get"
+	ActionAfterUpsert      ActionAfter = "after_upsert"
+	ActionAfterUndelete    ActionAfter = "after_undelete"
+	ActionAfterStreamList  ActionAfter = "after_stream_list"
+	ActionAfterWatch       ActionAfter = "after_watch"
+	ActionAfterCount       ActionAfter = "after_count"
+	ActionAfterAggregate   ActionAfter = "after_aggregate"
 )
 
 type HookAfter interface {
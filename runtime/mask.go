@@ -0,0 +1,65 @@
+package runtime
+
+import (
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// ApplyReadMask clears the fields of msg that aren't listed in the paths of the read mask. A nil or empty mask, or a
+// mask holding the "*" wildcard, keeps every field.
+func ApplyReadMask(msg proto.Message, mask *fieldmaskpb.FieldMask) {
+	if len(mask.GetPaths()) == 0 {
+		return
+	}
+	paths := make(map[protoreflect.Name]struct{}, len(mask.GetPaths()))
+	for _, p := range mask.GetPaths() {
+		if p == "*" {
+			return
+		}
+		paths[protoreflect.Name(p)] = struct{}{}
+	}
+	m := msg.ProtoReflect()
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if _, ok := paths[fd.Name()]; !ok {
+			m.Clear(fd)
+		}
+		return true
+	})
+}
+
+// UpdateMaskPaths returns the set of fields of an Update request listed in its update mask, or nil if the request has
+// no mask. The "*" path selects every field but the excluded ones, and the edges the request doesn't set, so that they
+// aren't cleared. Excluded paths can't be listed, nor can the paths that aren't top-level fields of the request.
+func UpdateMaskPaths(mask *fieldmaskpb.FieldMask, req proto.Message, edges []string, excluded ...string) (map[string]bool, error) {
+	if mask == nil {
+		return nil, nil
+	}
+	msg := req.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	paths := make(map[string]bool, len(mask.GetPaths()))
+	for _, p := range mask.GetPaths() {
+		if p == "*" {
+			for i := 0; i < fields.Len(); i++ {
+				fd := fields.Get(i)
+				name := string(fd.Name())
+				if slices.Contains(excluded, name) || (slices.Contains(edges, name) && !msg.Has(fd)) {
+					continue
+				}
+				paths[name] = true
+			}
+			continue
+		}
+		if fields.ByName(protoreflect.Name(p)) == nil {
+			return nil, fmt.Errorf("unknown update_mask path %q", p)
+		}
+		if slices.Contains(excluded, p) {
+			return nil, fmt.Errorf("update_mask can't list %q", p)
+		}
+		paths[p] = true
+	}
+	return paths, nil
+}
@@ -0,0 +1,102 @@
+package runtime
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func maskOf(paths ...string) *fieldmaskpb.FieldMask {
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
+
+func TestApplyReadMask(t *testing.T) {
+	Convey("Given a message", t, func() {
+		msg := &descriptorpb.DescriptorProto{
+			Name:    proto.String("User"),
+			Field:   []*descriptorpb.FieldDescriptorProto{{Name: proto.String("id")}},
+			Options: &descriptorpb.MessageOptions{Deprecated: proto.Bool(true)},
+		}
+
+		Convey("Then the fields missing from the mask are cleared", func() {
+			ApplyReadMask(msg, maskOf("name", "field"))
+			So(msg.GetName(), ShouldEqual, "User")
+			So(msg.GetField(), ShouldHaveLength, 1)
+			So(msg.GetOptions(), ShouldBeNil)
+		})
+		Convey("Then message fields are kept whole", func() {
+			ApplyReadMask(msg, maskOf("options"))
+			So(msg.GetOptions().GetDeprecated(), ShouldBeTrue)
+			So(msg.GetName(), ShouldBeEmpty)
+		})
+		Convey("Then nested paths don't select their parent field", func() {
+			ApplyReadMask(msg, maskOf("name", "options.deprecated"))
+			So(msg.GetName(), ShouldEqual, "User")
+			So(msg.GetOptions(), ShouldBeNil)
+		})
+		Convey("Then unknown paths select nothing", func() {
+			ApplyReadMask(msg, maskOf("name", "nickname"))
+			So(msg.GetName(), ShouldEqual, "User")
+			So(msg.GetField(), ShouldBeEmpty)
+		})
+		Convey("Then empty masks and the wildcard keep every field", func() {
+			ApplyReadMask(msg, nil)
+			ApplyReadMask(msg, maskOf())
+			ApplyReadMask(msg, maskOf("name", "*"))
+			So(msg.GetField(), ShouldHaveLength, 1)
+			So(msg.GetOptions(), ShouldNotBeNil)
+		})
+	})
+}
+
+func TestUpdateMaskPaths(t *testing.T) {
+	// The number and options fields stand for the id and update_mask fields of the Update requests.
+	Convey("Given an update request", t, func() {
+		req := &descriptorpb.FieldDescriptorProto{Number: proto.Int32(1), Name: proto.String("id")}
+
+		Convey("Then a request without mask updates the set fields", func() {
+			paths, err := UpdateMaskPaths(nil, req, nil, "number", "options")
+			So(err, ShouldBeNil)
+			So(paths, ShouldBeNil)
+		})
+		Convey("Then unset fields are listed to be cleared", func() {
+			paths, err := UpdateMaskPaths(maskOf("name", "json_name"), req, nil, "number", "options")
+			So(err, ShouldBeNil)
+			So(paths, ShouldResemble, map[string]bool{"name": true, "json_name": true})
+		})
+		Convey("Then the wildcard lists every field but the excluded ones", func() {
+			paths, err := UpdateMaskPaths(maskOf("*"), req, nil, "number", "options")
+			So(err, ShouldBeNil)
+			So(paths["name"], ShouldBeTrue)
+			So(paths["json_name"], ShouldBeTrue)
+			So(paths, ShouldNotContainKey, "number")
+			So(paths, ShouldNotContainKey, "options")
+		})
+		Convey("Then the wildcard only lists the edges set in the request", func() {
+			req.Options = &descriptorpb.FieldOptions{}
+			paths, err := UpdateMaskPaths(maskOf("*"), req, []string{"options", "type_name"}, "number")
+			So(err, ShouldBeNil)
+			So(paths["options"], ShouldBeTrue)
+			So(paths, ShouldNotContainKey, "type_name")
+
+			paths, err = UpdateMaskPaths(maskOf("type_name"), req, []string{"options", "type_name"}, "number")
+			So(err, ShouldBeNil)
+			So(paths["type_name"], ShouldBeTrue)
+		})
+		Convey("Then excluded paths are rejected", func() {
+			_, err := UpdateMaskPaths(maskOf("number"), req, nil, "number", "options")
+			So(err, ShouldNotBeNil)
+			_, err = UpdateMaskPaths(maskOf("options"), req, nil, "number", "options")
+			So(err, ShouldNotBeNil)
+		})
+		Convey("Then unknown and nested paths are rejected", func() {
+			_, err := UpdateMaskPaths(maskOf("nickname"), req, nil, "number", "options")
+			So(err, ShouldNotBeNil)
+			_, err = UpdateMaskPaths(maskOf("options.deprecated"), req, nil, "number", "options")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,110 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// Tx is the subset of a generated ent transaction used by the transactional methods.
+type Tx interface {
+	Commit() error
+	Rollback() error
+}
+
+// Rollback rolls back tx after err occurred. The rollback error, if any, is joined to err.
+func Rollback(tx Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return errors.Join(err, rerr)
+	}
+	return err
+}
+
+// NewItemRequest returns a request for a single item of the batch request req, carrying its headers. It lets the
+// batch methods run the hooks of the single-item methods with the request type they expect.
+func NewItemRequest[T, B any](req *connect.Request[B], msg *T) *connect.Request[T] {
+	itemReq := connect.NewRequest(msg)
+	for k, v := range req.Header().Clone() {
+		itemReq.Header()[k] = v
+	}
+	return itemReq
+}
+
+// RetryPolicy configures how the transactional methods of a service retry transactions failing to serialize.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times a transaction is run, including the first one. Values below 1 are treated
+	// as 1, disabling retries.
+	MaxAttempts int
+	// Backoff is the delay before the first retry, doubled for each of the following ones.
+	Backoff time.Duration
+	// Retryable reports whether a failed transaction can be retried. IsSerializationFailure is used if nil.
+	Retryable func(error) bool
+}
+
+// DefaultRetryPolicy is the RetryPolicy of a new BaseService.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	Backoff:     10 * time.Millisecond,
+}
+
+// IsSerializationFailure reports whether err is a serialization failure or a deadlock reported by the database, after
+// which the transaction can be run again.
+func IsSerializationFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	var state interface{ SQLState() string }
+	if errors.As(err, &state) {
+		switch state.SQLState() {
+		case "40001", "40P01":
+			return true
+		}
+	}
+	msg := err.Error()
+	for _, s := range []string{
+		"could not serialize access", // PostgreSQL
+		"try restarting transaction", // MySQL
+		"database is locked",         // SQLite
+	} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// RunInTx runs fn in a transaction opened by begin, and commits it if fn succeeds. The transaction is rolled back if
+// fn or the commit fails, and run again as long as the policy allows.
+func RunInTx[T Tx](ctx context.Context, policy RetryPolicy, begin func(context.Context) (T, error), fn func(ctx context.Context, tx T) error) error {
+	retryable := policy.Retryable
+	if retryable == nil {
+		retryable = IsSerializationFailure
+	}
+	backoff := policy.Backoff
+	for attempt := 1; ; attempt++ {
+		err := runTx(ctx, begin, fn)
+		if err == nil || attempt >= policy.MaxAttempts || !retryable(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func runTx[T Tx](ctx context.Context, begin func(context.Context) (T, error), fn func(ctx context.Context, tx T) error) error {
+	tx, err := begin(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(ctx, tx); err != nil {
+		return Rollback(tx, err)
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,72 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type testTx struct {
+	committed  bool
+	rolledBack bool
+}
+
+func (tx *testTx) Commit() error {
+	tx.committed = true
+	return nil
+}
+
+func (tx *testTx) Rollback() error {
+	tx.rolledBack = true
+	return nil
+}
+
+func TestRunInTx(t *testing.T) {
+	Convey("Given a transaction failing to serialize once", t, func() {
+		var txs []*testTx
+		begin := func(context.Context) (*testTx, error) {
+			tx := &testTx{}
+			txs = append(txs, tx)
+			return tx, nil
+		}
+		serializationErr := errors.New("pq: could not serialize access due to concurrent update")
+		fn := func(_ context.Context, _ *testTx) error {
+			if len(txs) == 1 {
+				return serializationErr
+			}
+			return nil
+		}
+
+		Convey("It is rolled back and run again", func() {
+			err := RunInTx(context.Background(), RetryPolicy{MaxAttempts: 2}, begin, fn)
+			So(err, ShouldBeNil)
+			So(txs, ShouldHaveLength, 2)
+			So(txs[0].rolledBack, ShouldBeTrue)
+			So(txs[0].committed, ShouldBeFalse)
+			So(txs[1].committed, ShouldBeTrue)
+		})
+
+		Convey("It isn't retried once the attempts are exhausted", func() {
+			err := RunInTx(context.Background(), RetryPolicy{MaxAttempts: 1}, begin, fn)
+			So(errors.Is(err, serializationErr), ShouldBeTrue)
+			So(txs, ShouldHaveLength, 1)
+			So(txs[0].rolledBack, ShouldBeTrue)
+		})
+	})
+
+	Convey("Given a transaction failing with another error", t, func() {
+		attempts := 0
+		begin := func(context.Context) (*testTx, error) {
+			attempts++
+			return &testTx{}, nil
+		}
+		otherErr := errors.New("constraint failed")
+		err := RunInTx(context.Background(), DefaultRetryPolicy, begin, func(context.Context, *testTx) error {
+			return otherErr
+		})
+		So(errors.Is(err, otherErr), ShouldBeTrue)
+		So(attempts, ShouldEqual, 1)
+	})
+}
@@ -17,6 +17,8 @@ package entproto
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema"
@@ -28,6 +30,10 @@ import (
 	_ "google.golang.org/protobuf/types/known/emptypb"
 )
 
+// MaxBatchSize is the maximum number of entries that can be addressed by a single BatchGet, BatchUpdate or BatchDelete
+// call. Requests exceeding this batch size will return an error.
+const MaxBatchSize = 1000
+
 const (
 	ServiceAnnotation = "ProtoService"
 	// MaxPageSize is the maximum page size that can be returned by a List call. Requesting page sizes larger than
@@ -46,7 +52,36 @@ const (
 	MethodDelete
 	// MethodList generates a List gRPC service method for the entproto.Service.
 	MethodList
-	// MethodAll generates all service methods for the entproto.Service. This is the same behavior as not including entproto.Methods.
+	// MethodBatchCreate generates a BatchCreate gRPC service method for the entproto.Service. It is not part of
+	// MethodAll.
+	MethodBatchCreate
+	// MethodBatchGet generates a BatchGet gRPC service method for the entproto.Service. It is not part of MethodAll.
+	MethodBatchGet
+	// MethodBatchUpdate generates a BatchUpdate gRPC service method for the entproto.Service. It is not part of
+	// MethodAll.
+	MethodBatchUpdate
+	// MethodBatchDelete generates a BatchDelete gRPC service method for the entproto.Service. It is not part of
+	// MethodAll.
+	MethodBatchDelete
+	// MethodStreamList generates a server-streaming StreamList gRPC service method for the entproto.Service. It is not
+	// part of MethodAll.
+	MethodStreamList
+	// MethodUpsert generates an Upsert gRPC service method for the entproto.Service. It requires the ent client to be
+	// generated with gen.FeatureUpsert, and is therefore not part of MethodAll.
+	MethodUpsert
+	// MethodWatch generates a server-streaming Watch gRPC service method for the entproto.Service, along with the
+	// publication of the changes made by its mutation methods. It requires MethodList or MethodStreamList, whose
+	// filter it takes, and is not part of MethodAll.
+	MethodWatch
+	// MethodCount generates a Count gRPC service method for the entproto.Service. It requires MethodList or
+	// MethodStreamList, whose filter it takes, and is not part of MethodAll.
+	MethodCount
+	// MethodAggregate generates an Aggregate gRPC service method for the entproto.Service, grouping by the fields
+	// annotated with entproto.Groupable. It requires MethodList or MethodStreamList, whose filter it takes, and is not
+	// part of MethodAll.
+	MethodAggregate
+	// MethodAll generates the Create, Get, Update, Delete and List methods for the entproto.Service. This is the same
+	// behavior as not including entproto.Methods. The other methods must be requested explicitly.
 	MethodAll = MethodCreate | MethodGet | MethodUpdate | MethodDelete | MethodList
 )
 
@@ -66,9 +101,41 @@ func Methods(methods Method) ServiceOption {
 	}
 }
 
+// DefaultWith sets the edges eager-loaded by the Get and List methods when the request doesn't select any in its with
+// field.
+func DefaultWith(edges ...string) ServiceOption {
+	return func(s *service) {
+		s.DefaultWith = edges
+	}
+}
+
+// Transactional runs the Create, Update, Delete, BatchCreate and Upsert methods inside an ent transaction, retried
+// according to the runtime.RetryPolicy of the service when it fails to serialize. The transaction is available to the
+// hooks through ent.TxFromContext, and is only committed once the after hooks succeed.
+func Transactional() ServiceOption {
+	return func(s *service) {
+		s.Transactional = true
+	}
+}
+
+// MaxWithDepth sets how deep the Get and List methods eager-load edges. The edges selected by the request are loaded
+// at depth 1, the default edges of their schemas are loaded at depth 2, and so on. By default, only the edges
+// selected by the request are loaded.
+func MaxWithDepth(depth int) ServiceOption {
+	return func(s *service) {
+		s.MaxWithDepth = depth
+	}
+}
+
 type service struct {
-	Generate bool
-	Methods  Method
+	Generate     bool
+	Methods      Method
+	DefaultWith  []string
+	MaxWithDepth int
+	// Transactional runs the mutations of the service and their hooks inside a transaction.
+	Transactional bool
+	// RPCs are the custom methods of the service declared with entproto.RPC.
+	RPCs []*rpc
 }
 
 func (service) Name() string {
@@ -90,12 +157,16 @@ func Service(opts ...ServiceOption) schema.Annotation {
 	if s.Methods == 0 {
 		s.Methods = MethodAll
 	}
+	if s.MaxWithDepth == 0 {
+		s.MaxWithDepth = 1
+	}
 	return s
 }
 
-func (a *Adapter) createServiceResources(genType *gen.Type, methods Method) (serviceResources, error) {
+func (a *Adapter) createServiceResources(genType *gen.Type, svcAnnotation *service) (serviceResources, error) {
 	name := genType.Name
 	serviceFqn := fmt.Sprintf("%sService", name)
+	methods := svcAnnotation.Methods
 
 	out := serviceResources{
 		svc: &descriptorpb.ServiceDescriptorProto{
@@ -103,7 +174,27 @@ func (a *Adapter) createServiceResources(genType *gen.Type, methods Method) (ser
 		},
 	}
 
-	for _, m := range []Method{MethodCreate, MethodGet, MethodUpdate, MethodDelete, MethodList} {
+	if methods.Is(MethodGet | MethodList | MethodStreamList) {
+		edgeEnum, err := extractEdgeEnum(genType)
+		if err != nil {
+			return serviceResources{}, err
+		}
+		if err := svcAnnotation.verifyWith(genType, edgeEnum); err != nil {
+			return serviceResources{}, err
+		}
+		if edgeEnum != nil {
+			out.svcEnums = append(out.svcEnums, edgeEnum)
+		}
+	}
+
+	if methods.Is(MethodCount|MethodAggregate|MethodWatch) && !methods.Is(MethodList|MethodStreamList) {
+		return serviceResources{}, fmt.Errorf("entproto: the Count, Aggregate and Watch methods of schema %q take the "+
+			"filter of the List method, and require entproto.MethodList or entproto.MethodStreamList", genType.Name)
+	}
+
+	for _, m := range []Method{MethodCreate, MethodGet, MethodUpdate, MethodDelete, MethodList, MethodBatchCreate,
+		MethodBatchGet, MethodBatchUpdate, MethodBatchDelete, MethodStreamList, MethodUpsert, MethodCount,
+		MethodAggregate, MethodWatch} {
 		if !methods.Is(m) {
 			continue
 		}
@@ -114,8 +205,23 @@ func (a *Adapter) createServiceResources(genType *gen.Type, methods Method) (ser
 		}
 		out.svc.Method = append(out.svc.Method, resources.methodDescriptor)
 		out.svcMessages = append(out.svcMessages, resources.messages...)
+		out.svcEnums = append(out.svcEnums, resources.enums...)
+	}
+	if methods.Is(MethodDelete | MethodBatchDelete) {
+		resources, err := a.genUndeleteProtos(genType)
+		if err != nil {
+			return serviceResources{}, err
+		}
+		if resources.methodDescriptor != nil {
+			out.svc.Method = append(out.svc.Method, resources.methodDescriptor)
+			out.svcMessages = append(out.svcMessages, resources.messages...)
+		}
 	}
 	out.svcMessages = dedupeServiceMessages(out.svcMessages)
+	out.svcEnums = dedupeServiceEnums(out.svcEnums)
+	if err := a.addRPCResources(genType, svcAnnotation, &out); err != nil {
+		return serviceResources{}, err
+	}
 
 	return out, nil
 }
@@ -128,16 +234,40 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 	repeatedFieldLabel := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
 	noSideEffectIdempotencyLevel := descriptorpb.MethodOptions_NO_SIDE_EFFECTS
 	messages := []*descriptorpb.DescriptorProto{}
+	var enums []*descriptorpb.EnumDescriptorProto
 	method := &descriptorpb.MethodDescriptorProto{}
 
 	switch m {
 	case MethodGet:
+		idField, err := a.extractIDFieldDescriptor(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+
 		method.Name = strptr("Get")
-		method.InputType = strptr("google.protobuf.Int32Value")
+		method.InputType = strptr(fmt.Sprintf("Get%sRequest", genType.Name))
 		method.OutputType = strptr(genType.Name)
 		method.Options = &descriptorpb.MethodOptions{
 			IdempotencyLevel: &noSideEffectIdempotencyLevel,
 		}
+
+		input.Name = method.InputType
+		input.Field = []*descriptorpb.FieldDescriptorProto{idField}
+		if withField, err := extractWithField(genType, 2); err != nil {
+			return methodResources{}, err
+		} else if withField != nil {
+			input.Field = append(input.Field, withField)
+		}
+		input.Field = append(input.Field, fieldMaskField("read_mask", 3))
+		if softDeleteField, err := ExtractSoftDeleteField(genType); err != nil {
+			return methodResources{}, err
+		} else if softDeleteField != nil {
+			input.Field = append(input.Field, showDeletedField(getShowDeletedFieldNumber))
+		}
+		if err := verifyNoFieldNumberCollision(input); err != nil {
+			return methodResources{}, err
+		}
+		messages = append(messages, input)
 	case MethodCreate:
 		method.Name = strptr("Create")
 		method.InputType = strptr(genType.Name)
@@ -147,68 +277,201 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 		method.InputType = strptr(fmt.Sprintf("Update%sRequest", genType.Name))
 		method.OutputType = strptr(genType.Name)
 
+		updateRequest, err := a.extractUpdateRequest(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		messages = append(messages, updateRequest)
+	case MethodDelete:
+		idField, err := a.extractIDFieldDescriptor(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+
+		method.Name = strptr("Delete")
+		method.InputType = strptr(fmt.Sprintf("Delete%sRequest", genType.Name))
+		method.OutputType = strptr("google.protobuf.Empty")
+
 		input.Name = method.InputType
-		input.Field = []*descriptorpb.FieldDescriptorProto{}
+		input.Field = []*descriptorpb.FieldDescriptorProto{idField}
+		messages = append(messages, input)
+	case MethodBatchCreate:
+		method.Name = strptr("BatchCreate")
+		method.InputType = strptr(fmt.Sprintf("BatchCreate%sRequest", genType.Name))
+		method.OutputType = strptr(fmt.Sprintf("BatchCreate%sResponse", genType.Name))
 
-		all := []*gen.Field{genType.ID}
-		all = append(all, genType.Fields...)
-		for _, genField := range all {
-			if genField.Immutable {
-				continue // Immutable fields are not included in Update requests.
-			}
+		input.Name = method.InputType
+		input.Field = []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     strptr("items"),
+				Number:   int32ptr(1),
+				Label:    &repeatedFieldLabel,
+				Type:     &protoMessageFieldType,
+				TypeName: strptr(genType.Name),
+			},
+		}
+		output := &descriptorpb.DescriptorProto{
+			Name: method.OutputType,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     strptr("items"),
+					Number:   int32ptr(1),
+					Label:    &repeatedFieldLabel,
+					Type:     &protoMessageFieldType,
+					TypeName: strptr(genType.Name),
+				},
+			},
+		}
+		messages = append(messages, input, output)
+	case MethodBatchGet:
+		idField, err := a.extractIDFieldDescriptor(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
 
-			var optionalFieldType convert.FieldType
-			var err error
-			if genField.Type.Type != field.TypeEnum {
-				optionalFieldType, err = converter.ExtractProtoTypeDetails(genField, input, genField.Name != "id")
-				if err != nil {
-					return methodResources{}, fmt.Errorf("entproto: unable to extract proto type details for schema %q field %q: %w",
-						genType.Name, genField.Name, err)
-				}
-			} else {
-				optionalFieldType = convert.FieldType{
-					MessageName: pascal(genType.Name + "_" + genField.Name + "_enum_value"),
-					ProtoType:   descriptorpb.FieldDescriptorProto_TYPE_MESSAGE,
-					Repeated:    false,
-				}
-			}
+		method.Name = strptr("BatchGet")
+		method.InputType = strptr(fmt.Sprintf("BatchGet%sRequest", genType.Name))
+		method.OutputType = strptr(fmt.Sprintf("BatchGet%sResponse", genType.Name))
+		method.Options = &descriptorpb.MethodOptions{
+			IdempotencyLevel: &noSideEffectIdempotencyLevel,
+		}
 
-			input.Field = append(input.Field, &descriptorpb.FieldDescriptorProto{
-				Name:     strptr(snake(genField.Name)),
-				Number:   int32ptr(int32(len(input.Field) + 1)),
-				Type:     &optionalFieldType.ProtoType,
-				TypeName: strptr(optionalFieldType.MessageName),
-			})
+		input.Name = method.InputType
+		input.Field = []*descriptorpb.FieldDescriptorProto{repeatedIDField(idField, "ids", 1)}
+		output := &descriptorpb.DescriptorProto{
+			Name: method.OutputType,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     strptr("items"),
+					Number:   int32ptr(1),
+					Label:    &repeatedFieldLabel,
+					Type:     &protoMessageFieldType,
+					TypeName: strptr(genType.Name),
+				},
+				repeatedIDField(idField, "missing_ids", 2),
+			},
+		}
+		messages = append(messages, input, output)
+	case MethodBatchUpdate:
+		updateRequest, err := a.extractUpdateRequest(genType)
+		if err != nil {
+			return methodResources{}, err
 		}
 
-		for _, e := range genType.Edges {
-			descriptor, err := converter.ExtractEdgeFieldDescriptor(a.graph, genType, e)
-			if err != nil {
-				return methodResources{}, fmt.Errorf("entproto: unable to extract edge field descriptor for schema %q edge %q: %w",
-					genType.Name, e.Name, err)
-			}
-			if descriptor != nil {
-				input.Field = append(input.Field, &descriptorpb.FieldDescriptorProto{
-					Name:     descriptor.Name,
-					Number:   int32ptr(int32(len(input.Field) + 1)),
-					Type:     descriptor.Type,
-					Label:    descriptor.Label,
-					TypeName: descriptor.TypeName,
-				})
-			}
+		method.Name = strptr("BatchUpdate")
+		method.InputType = strptr(fmt.Sprintf("BatchUpdate%sRequest", genType.Name))
+		method.OutputType = strptr(fmt.Sprintf("BatchUpdate%sResponse", genType.Name))
+
+		input.Name = method.InputType
+		input.Field = []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     strptr("requests"),
+				Number:   int32ptr(1),
+				Label:    &repeatedFieldLabel,
+				Type:     &protoMessageFieldType,
+				TypeName: updateRequest.Name,
+			},
+		}
+		output := &descriptorpb.DescriptorProto{
+			Name: method.OutputType,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     strptr("items"),
+					Number:   int32ptr(1),
+					Label:    &repeatedFieldLabel,
+					Type:     &protoMessageFieldType,
+					TypeName: strptr(genType.Name),
+				},
+			},
+		}
+		messages = append(messages, updateRequest, input, output)
+	case MethodBatchDelete:
+		idField, err := a.extractIDFieldDescriptor(genType)
+		if err != nil {
+			return methodResources{}, err
 		}
 
-		messages = append(messages, input)
-	case MethodDelete:
-		method.Name = strptr("Delete")
-		method.InputType = strptr("google.protobuf.Int32Value")
+		method.Name = strptr("BatchDelete")
+		method.InputType = strptr(fmt.Sprintf("BatchDelete%sRequest", genType.Name))
 		method.OutputType = strptr("google.protobuf.Empty")
+
+		input.Name = method.InputType
+		input.Field = []*descriptorpb.FieldDescriptorProto{repeatedIDField(idField, "ids", 1)}
+		// The hooks of the Delete method are run for each ID, with a Delete<T>Request.
+		deleteRequest := &descriptorpb.DescriptorProto{
+			Name:  strptr(fmt.Sprintf("Delete%sRequest", genType.Name)),
+			Field: []*descriptorpb.FieldDescriptorProto{idField},
+		}
+		messages = append(messages, deleteRequest, input)
+	case MethodStreamList:
+		// StreamList takes the same request as List, and is generated along with its messages.
+		listResources, err := a.genMethodProtos(genType, MethodList)
+		if err != nil {
+			return methodResources{}, err
+		}
+		serverStreaming := true
+
+		method.Name = strptr("StreamList")
+		method.InputType = listResources.methodDescriptor.InputType
+		method.OutputType = strptr(fmt.Sprintf("StreamList%sResponse", genType.Name))
+		method.ServerStreaming = &serverStreaming
+		method.Options = &descriptorpb.MethodOptions{
+			IdempotencyLevel: &noSideEffectIdempotencyLevel,
+		}
+
+		output := &descriptorpb.DescriptorProto{
+			Name: method.OutputType,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     strptr("items"),
+					Number:   int32ptr(1),
+					Label:    &repeatedFieldLabel,
+					Type:     &protoMessageFieldType,
+					TypeName: strptr(genType.Name),
+				},
+			},
+		}
+		messages = append(messages, listResources.messages...)
+		messages = append(messages, output)
+		enums = append(enums, listResources.enums...)
+	case MethodUpsert:
+		if err := a.verifyUpsertFeature(genType); err != nil {
+			return methodResources{}, err
+		}
+		conflictEnum, err := extractConflictTargetEnum(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		enumFieldType := descriptorpb.FieldDescriptorProto_TYPE_ENUM
+
+		method.Name = strptr("Upsert")
+		method.InputType = strptr(fmt.Sprintf("Upsert%sRequest", genType.Name))
+		method.OutputType = strptr(genType.Name)
+
+		input.Name = method.InputType
+		input.Field = []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     strptr(snake(genType.Name)),
+				Number:   int32ptr(1),
+				Type:     &protoMessageFieldType,
+				TypeName: strptr(genType.Name),
+			},
+			{
+				Name:     strptr("on_conflict"),
+				Number:   int32ptr(2),
+				Type:     &enumFieldType,
+				TypeName: conflictEnum.Name,
+			},
+		}
+		messages = append(messages, input)
+		enums = append(enums, conflictEnum)
 	case MethodList:
 		if !(genType.ID.Type.Type.Integer() || genType.ID.IsUUID() || genType.ID.IsString()) {
 			return methodResources{}, fmt.Errorf("entproto: list method does not support schema %q id type %q",
 				genType.Name, genType.ID.Type.String())
 		}
 		int32FieldType := descriptorpb.FieldDescriptorProto_TYPE_INT32
+		stringFieldType := descriptorpb.FieldDescriptorProto_TYPE_STRING
 
 		method.Name = strptr("List")
 		method.InputType = strptr(fmt.Sprintf("List%sRequest", genType.Name))
@@ -222,6 +485,28 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 			Field: []*descriptorpb.FieldDescriptorProto{},
 		}
 
+		orderEnum, err := a.extractOrderFieldEnum(genType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		enumFieldType := descriptorpb.FieldDescriptorProto_TYPE_ENUM
+		orderMessage := &descriptorpb.DescriptorProto{
+			Name: strptr(fmt.Sprintf("List%sOrder", genType.Name)),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     strptr("field"),
+					Number:   int32ptr(1),
+					Type:     &enumFieldType,
+					TypeName: orderEnum.Name,
+				},
+				{
+					Name:   strptr("descending"),
+					Number: int32ptr(2),
+					Type:   &boolFieldType,
+				},
+			},
+		}
+
 		input.Name = method.InputType
 		input.Field = []*descriptorpb.FieldDescriptorProto{
 			{
@@ -236,17 +521,6 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 				Type:     &protoMessageFieldType,
 				TypeName: strptr("google.protobuf.Int32Value"),
 			},
-			{
-				Name:     strptr("order"),
-				Number:   int32ptr(3),
-				Type:     &protoMessageFieldType,
-				TypeName: strptr("google.protobuf.StringValue"),
-			},
-			{
-				Name:   strptr("descending"),
-				Number: int32ptr(4),
-				Type:   &boolFieldType,
-			},
 			{
 				Name:     strptr("filter"),
 				Number:   int32ptr(5),
@@ -258,6 +532,32 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 				Number: int32ptr(6),
 				Type:   &boolFieldType,
 			},
+			{
+				Name:   strptr("page_token"),
+				Number: int32ptr(7),
+				Type:   &stringFieldType,
+			},
+			{
+				Name:     strptr("order"),
+				Number:   int32ptr(8),
+				Type:     &protoMessageFieldType,
+				TypeName: strptr(orderMessage.GetName()),
+				Label:    &repeatedFieldLabel,
+			},
+		}
+		// Numbers 3 and 4 were used by the free-form order column and its direction.
+		input.ReservedRange = []*descriptorpb.DescriptorProto_ReservedRange{{Start: int32ptr(3), End: int32ptr(5)}}
+		input.ReservedName = []string{"descending"}
+		if withField, err := extractWithField(genType, 9); err != nil {
+			return methodResources{}, err
+		} else if withField != nil {
+			input.Field = append(input.Field, withField)
+		}
+		input.Field = append(input.Field, fieldMaskField("read_mask", 10))
+		if softDeleteField, err := ExtractSoftDeleteField(genType); err != nil {
+			return methodResources{}, err
+		} else if softDeleteField != nil {
+			input.Field = append(input.Field, showDeletedField(listShowDeletedFieldNumber))
 		}
 
 		for _, genField := range genType.Fields {
@@ -268,8 +568,19 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 			}
 
 			if filterAnnotation != nil {
+				fieldAnnotation, err := annotations.ExtractFieldAnnotation(genField)
+				if err != nil {
+					return methodResources{}, err
+				}
+				filterNumber := func(mode FilterMode) (*int32, error) {
+					num, err := filterAnnotation.Number(mode, fieldAnnotation.Number)
+					if err != nil {
+						return nil, fmt.Errorf("entproto: schema %q field %q: %w", genType.Name, genField.Name, err)
+					}
+					return int32ptr(int32(num)), nil
+				}
+
 				var optionalFieldType convert.FieldType
-				var err error
 				if genField.Type.Type != field.TypeEnum {
 					optionalFieldType, err = converter.ExtractProtoTypeDetails(genField, input, true)
 					if err != nil {
@@ -295,53 +606,236 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 				}
 
 				if filterAnnotation.Mode&FilterModeEQ != 0 {
+					number, err := filterNumber(FilterModeEQ)
+					if err != nil {
+						return methodResources{}, err
+					}
 					filterMessage.Field = append(filterMessage.Field, &descriptorpb.FieldDescriptorProto{
 						Name:     strptr(snake(genField.Name)),
-						Number:   int32ptr(int32(len(filterMessage.Field) + 1)),
+						Number:   number,
 						Type:     &optionalFieldType.ProtoType,
 						TypeName: strptr(optionalFieldType.MessageName),
 					})
 				}
-				if filterAnnotation.Mode&FilterModeContains != 0 {
+				for _, match := range stringFilterModes {
+					if filterAnnotation.Mode&match.mode == 0 {
+						continue
+					}
 					if genField.Type.Type != field.TypeString {
-						return methodResources{}, fmt.Errorf("entproto: contains filter mode is only supported for string fields, schema %q field %q has type %q",
-							genType.Name, genField.Name, genField.Type.Type)
+						return methodResources{}, fmt.Errorf("entproto: %s filter mode is only supported for string fields, schema %q field %q has type %q",
+							match.mode, genType.Name, genField.Name, genField.Type.Type)
+					}
+					number, err := filterNumber(match.mode)
+					if err != nil {
+						return methodResources{}, err
 					}
 					filterMessage.Field = append(filterMessage.Field, &descriptorpb.FieldDescriptorProto{
-						Name:     strptr(fmt.Sprintf("%s_contains", snake(genField.Name))),
-						Number:   int32ptr(int32(len(filterMessage.Field) + 1)),
+						Name:     strptr(fmt.Sprintf("%s_%s", snake(genField.Name), match.suffix)),
+						Number:   number,
 						Type:     &optionalFieldType.ProtoType,
 						TypeName: strptr(optionalFieldType.MessageName),
 					})
 				}
 				if filterAnnotation.Mode&FilterModeIn != 0 {
+					number, err := filterNumber(FilterModeIn)
+					if err != nil {
+						return methodResources{}, err
+					}
 					filterMessage.Field = append(filterMessage.Field, &descriptorpb.FieldDescriptorProto{
 						Name:     strptr(fmt.Sprintf("%s_in", snake(genField.Name))),
-						Number:   int32ptr(int32(len(filterMessage.Field) + 1)),
+						Number:   number,
 						Type:     &originalFieldType.ProtoType,
 						TypeName: strptr(originalFieldType.MessageName),
 						Label:    &repeatedFieldLabel,
 					})
 				}
+				if filterAnnotation.Mode&FilterModeNEQ != 0 {
+					number, err := filterNumber(FilterModeNEQ)
+					if err != nil {
+						return methodResources{}, err
+					}
+					filterMessage.Field = append(filterMessage.Field, &descriptorpb.FieldDescriptorProto{
+						Name:     strptr(fmt.Sprintf("%s_neq", snake(genField.Name))),
+						Number:   number,
+						Type:     &optionalFieldType.ProtoType,
+						TypeName: strptr(optionalFieldType.MessageName),
+					})
+				}
+				if filterAnnotation.Mode&FilterModeNotIn != 0 {
+					number, err := filterNumber(FilterModeNotIn)
+					if err != nil {
+						return methodResources{}, err
+					}
+					filterMessage.Field = append(filterMessage.Field, &descriptorpb.FieldDescriptorProto{
+						Name:     strptr(fmt.Sprintf("%s_not_in", snake(genField.Name))),
+						Number:   number,
+						Type:     &originalFieldType.ProtoType,
+						TypeName: strptr(originalFieldType.MessageName),
+						Label:    &repeatedFieldLabel,
+					})
+				}
+				if filterAnnotation.Mode&FilterModeIsNil != 0 {
+					if !genField.Optional && !genField.Nillable {
+						return methodResources{}, fmt.Errorf("entproto: is-nil filter mode is only supported for optional or nillable fields, schema %q field %q is required",
+							genType.Name, genField.Name)
+					}
+					number, err := filterNumber(FilterModeIsNil)
+					if err != nil {
+						return methodResources{}, err
+					}
+					filterMessage.Field = append(filterMessage.Field, &descriptorpb.FieldDescriptorProto{
+						Name:     strptr(fmt.Sprintf("%s_is_nil", snake(genField.Name))),
+						Number:   number,
+						Type:     &protoMessageFieldType,
+						TypeName: strptr("google.protobuf.BoolValue"),
+					})
+				}
+				for _, cmp := range comparisonFilterModes {
+					if filterAnnotation.Mode&cmp.mode == 0 {
+						continue
+					}
+					if !genField.Type.Numeric() && genField.Type.Type != field.TypeTime {
+						return methodResources{}, fmt.Errorf("entproto: %s filter mode is only supported for numeric and time fields, schema %q field %q has type %q",
+							cmp.mode, genType.Name, genField.Name, genField.Type.Type)
+					}
+					number, err := filterNumber(cmp.mode)
+					if err != nil {
+						return methodResources{}, err
+					}
+					filterMessage.Field = append(filterMessage.Field, &descriptorpb.FieldDescriptorProto{
+						Name:     strptr(fmt.Sprintf("%s_%s", snake(genField.Name), cmp.suffix)),
+						Number:   number,
+						Type:     &optionalFieldType.ProtoType,
+						TypeName: strptr(optionalFieldType.MessageName),
+					})
+				}
+			}
+		}
+
+		for _, e := range genType.Edges {
+			filterAnnotation, err := annotations.ExtractEdgeFilterAnnotation(e)
+			if err != nil {
+				return methodResources{}, err
+			}
+			if filterAnnotation == nil {
+				continue
+			}
+			if unsupported := filterAnnotation.Mode &^ (FilterModeEQ | FilterModeIn | FilterModeHasEdge); unsupported != 0 {
+				return methodResources{}, fmt.Errorf("entproto: edge %q of schema %q only supports the EQ, In and HasEdge filter modes",
+					e.Name, genType.Name)
+			}
+			edgeAnnotation, err := annotations.ExtractEdgeAnnotation(e)
+			if err != nil {
+				return methodResources{}, err
+			}
+			filterNumber := func(mode FilterMode) (*int32, error) {
+				num, err := filterAnnotation.Number(mode, edgeAnnotation.Number)
+				if err != nil {
+					return nil, fmt.Errorf("entproto: schema %q edge %q: %w", genType.Name, e.Name, err)
+				}
+				return int32ptr(int32(num)), nil
+			}
+
+			if filterAnnotation.Mode&FilterModeHasEdge != 0 {
+				number, err := filterNumber(FilterModeHasEdge)
+				if err != nil {
+					return methodResources{}, err
+				}
+				filterMessage.Field = append(filterMessage.Field, &descriptorpb.FieldDescriptorProto{
+					Name:     strptr(fmt.Sprintf("has_%s", snake(e.Name))),
+					Number:   number,
+					Type:     &protoMessageFieldType,
+					TypeName: strptr("google.protobuf.BoolValue"),
+				})
+			}
+			if filterAnnotation.Mode&FilterModeEQ != 0 {
+				idType, err := converter.ExtractProtoTypeDetails(e.Type.ID, input, true)
+				if err != nil {
+					return methodResources{}, fmt.Errorf("entproto: unable to extract id type of schema %q edge %q: %w",
+						genType.Name, e.Name, err)
+				}
+				number, err := filterNumber(FilterModeEQ)
+				if err != nil {
+					return methodResources{}, err
+				}
+				filterMessage.Field = append(filterMessage.Field, &descriptorpb.FieldDescriptorProto{
+					Name:     strptr(fmt.Sprintf("%s_id", snake(e.Name))),
+					Number:   number,
+					Type:     &idType.ProtoType,
+					TypeName: strptr(idType.MessageName),
+				})
+			}
+			if filterAnnotation.Mode&FilterModeIn != 0 {
+				idType, err := converter.ExtractProtoTypeDetails(e.Type.ID, input)
+				if err != nil {
+					return methodResources{}, fmt.Errorf("entproto: unable to extract id type of schema %q edge %q: %w",
+						genType.Name, e.Name, err)
+				}
+				number, err := filterNumber(FilterModeIn)
+				if err != nil {
+					return methodResources{}, err
+				}
+				filterMessage.Field = append(filterMessage.Field, &descriptorpb.FieldDescriptorProto{
+					Name:     strptr(fmt.Sprintf("%s_id_in", snake(e.Name))),
+					Number:   number,
+					Type:     &idType.ProtoType,
+					TypeName: strptr(idType.MessageName),
+					Label:    &repeatedFieldLabel,
+				})
 			}
 		}
 
-		extraFilterAnnotation, err := extractExtraFilterAnnotation(genType)
+		extraFilterAnnotation, err := ExtractExtraFilterAnnotation(genType)
 		if err != nil {
 			return methodResources{}, fmt.Errorf("entproto: unable to decode entproto.ExtraFilter annotation for schema %q: %w",
 				genType.Name, err)
 		}
 		if extraFilterAnnotation != nil {
 			for _, descriptor := range extraFilterAnnotation.ExtraFields {
+				number, ok := extraFilterAnnotation.Numbers[descriptor.Name]
+				if !ok {
+					return methodResources{}, fmt.Errorf("entproto: extra filter field %q of schema %q is not annotated with entproto.Field",
+						descriptor.Name, genType.Name)
+				}
 				filterMessage.Field = append(filterMessage.Field, &descriptorpb.FieldDescriptorProto{
 					Name:     strptr(snake(descriptor.Name)),
-					Number:   int32ptr(int32(len(filterMessage.Field) + 1)),
+					Number:   int32ptr(int32(number)),
 					Type:     &protoMessageFieldType,
 					TypeName: strptr(convert.TypeMap[descriptor.Info.Type].OptionalType),
 				})
 			}
 		}
 
+		filterMessage.Field = append(filterMessage.Field,
+			&descriptorpb.FieldDescriptorProto{
+				Name:     strptr("and"),
+				Number:   int32ptr(filterAndFieldNumber),
+				Label:    &repeatedFieldLabel,
+				Type:     &protoMessageFieldType,
+				TypeName: strptr(filterMessage.GetName()),
+			},
+			&descriptorpb.FieldDescriptorProto{
+				Name:     strptr("or"),
+				Number:   int32ptr(filterOrFieldNumber),
+				Label:    &repeatedFieldLabel,
+				Type:     &protoMessageFieldType,
+				TypeName: strptr(filterMessage.GetName()),
+			},
+			&descriptorpb.FieldDescriptorProto{
+				Name:     strptr("not"),
+				Number:   int32ptr(filterNotFieldNumber),
+				Type:     &protoMessageFieldType,
+				TypeName: strptr(filterMessage.GetName()),
+			},
+		)
+
+		if err := reserveMessageFields(genType, filterMessage); err != nil {
+			return methodResources{}, err
+		}
+		if err := verifyNoFieldNumberCollision(filterMessage); err != nil {
+			return methodResources{}, err
+		}
+
 		method.OutputType = strptr(fmt.Sprintf("List%sResponse", genType.Name))
 		output := &descriptorpb.DescriptorProto{
 			Name: method.OutputType,
@@ -358,29 +852,405 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 					Number: int32ptr(2),
 					Type:   &int32FieldType,
 				},
+				{
+					Name:   strptr("next_page_token"),
+					Number: int32ptr(3),
+					Type:   &stringFieldType,
+				},
 			},
 		}
-		messages = append(messages, filterMessage, input, output)
+		messages = append(messages, orderMessage, filterMessage, input, output)
+		enums = append(enums, orderEnum)
+	case MethodCount:
+		return a.genCountProtos(genType)
+	case MethodAggregate:
+		return a.genAggregateProtos(genType)
+	case MethodWatch:
+		return a.genWatchProtos(genType)
 	default:
 		return methodResources{}, fmt.Errorf("unknown method %q", m)
 	}
 	return methodResources{
 		methodDescriptor: method,
 		messages:         messages,
+		enums:            enums,
 	}, nil
 }
 
+// extractOrderFieldEnum returns the <T>OrderField enum listing the fields the results of the List method can be
+// ordered by: the ID and the fields annotated with entproto.Sortable. Values are numbered after the proto field
+// numbers of the fields, so they stay stable as fields are added or removed.
+func (a *Adapter) extractOrderFieldEnum(genType *gen.Type) (*descriptorpb.EnumDescriptorProto, error) {
+	orderAnnotation, err := annotations.ExtractOrderAnnotation(genType)
+	if err != nil {
+		return nil, err
+	}
+	if err := orderAnnotation.Verify(genType); err != nil {
+		return nil, err
+	}
+	name := fmt.Sprintf("%sOrderField", genType.Name)
+	prefix := strings.ToUpper(snake(name))
+	enum := &descriptorpb.EnumDescriptorProto{
+		Name: strptr(name),
+		Value: []*descriptorpb.EnumValueDescriptorProto{
+			{Name: strptr(prefix + "_UNSPECIFIED"), Number: int32ptr(0)},
+		},
+	}
+	for _, fld := range append([]*gen.Field{genType.ID}, genType.Fields...) {
+		if fld != genType.ID && !annotations.IsSortable(fld) {
+			continue
+		}
+		if fld.Type.Type == field.TypeJSON {
+			return nil, fmt.Errorf("entproto: json field %q of schema %q cannot be sortable", fld.Name, genType.Name)
+		}
+		fieldAnnotation, err := annotations.ExtractFieldAnnotation(fld)
+		if err != nil {
+			return nil, err
+		}
+		enum.Value = append(enum.Value, &descriptorpb.EnumValueDescriptorProto{
+			Name:   strptr(prefix + "_" + strings.ToUpper(snake(fld.Name))),
+			Number: int32ptr(int32(fieldAnnotation.Number)),
+		})
+	}
+	return enum, nil
+}
+
+// stringFilterModes lists the filter modes matching a string field, and the suffix of the List<T>Filter field
+// generated for each of them.
+var stringFilterModes = []struct {
+	mode   FilterMode
+	suffix string
+}{
+	{FilterModeContains, "contains"},
+	{FilterModeHasPrefix, "has_prefix"},
+	{FilterModeHasSuffix, "has_suffix"},
+	{FilterModeEqualFold, "equal_fold"},
+	{FilterModeContainsFold, "contains_fold"},
+}
+
+// comparisonFilterModes lists the filter modes comparing a field to a value, and the suffix of the List<T>Filter field
+// generated for each of them.
+var comparisonFilterModes = []struct {
+	mode   FilterMode
+	suffix string
+}{
+	{FilterModeGT, "gt"},
+	{FilterModeGTE, "gte"},
+	{FilterModeLT, "lt"},
+	{FilterModeLTE, "lte"},
+}
+
+// extractUpdateRequest returns the Update<T>Request message, shared by the Update and BatchUpdate methods.
+func (a *Adapter) extractUpdateRequest(genType *gen.Type) (*descriptorpb.DescriptorProto, error) {
+	converter := a.converters[genType]
+	idField, err := a.extractIDFieldDescriptor(genType)
+	if err != nil {
+		return nil, err
+	}
+	input := &descriptorpb.DescriptorProto{
+		Name:  strptr(fmt.Sprintf("Update%sRequest", genType.Name)),
+		Field: []*descriptorpb.FieldDescriptorProto{idField},
+	}
+
+	for _, genField := range genType.Fields {
+		if genField.Immutable {
+			continue // Immutable fields are not included in Update requests.
+		}
+		if _, ok := genField.Annotations[annotations.SkipAnnotation]; ok {
+			continue
+		}
+		fieldAnnotation, err := annotations.ExtractFieldAnnotation(genField)
+		if err != nil {
+			return nil, err
+		}
+
+		var optionalFieldType convert.FieldType
+		if genField.Type.Type != field.TypeEnum {
+			optionalFieldType, err = converter.ExtractProtoTypeDetails(genField, input, true)
+			if err != nil {
+				return nil, fmt.Errorf("entproto: unable to extract proto type details for schema %q field %q: %w",
+					genType.Name, genField.Name, err)
+			}
+		} else {
+			optionalFieldType = convert.FieldType{
+				MessageName: pascal(genType.Name + "_" + genField.Name + "_enum_value"),
+				ProtoType:   descriptorpb.FieldDescriptorProto_TYPE_MESSAGE,
+				Repeated:    false,
+			}
+		}
+
+		input.Field = append(input.Field, &descriptorpb.FieldDescriptorProto{
+			Name:     strptr(snake(genField.Name)),
+			Number:   int32ptr(int32(fieldAnnotation.Number)),
+			Type:     &optionalFieldType.ProtoType,
+			TypeName: strptr(optionalFieldType.MessageName),
+		})
+	}
+
+	for _, e := range genType.Edges {
+		if _, ok := e.Annotations[annotations.SkipAnnotation]; ok {
+			continue
+		}
+		descriptor, err := converter.ExtractEdgeFieldDescriptor(a.graph, genType, e)
+		if err != nil {
+			return nil, fmt.Errorf("entproto: unable to extract edge field descriptor for schema %q edge %q: %w",
+				genType.Name, e.Name, err)
+		}
+		if descriptor != nil {
+			input.Field = append(input.Field, &descriptorpb.FieldDescriptorProto{
+				Name:     descriptor.Name,
+				Number:   descriptor.Number,
+				Type:     descriptor.Type,
+				Label:    descriptor.Label,
+				TypeName: descriptor.TypeName,
+			})
+		}
+		opFields, err := extractEdgeUpdateFields(converter, input, genType, e)
+		if err != nil {
+			return nil, err
+		}
+		input.Field = append(input.Field, opFields...)
+	}
+
+	input.Field = append(input.Field, fieldMaskField("update_mask", updateMaskFieldNumber))
+
+	if err := reserveMessageFields(genType, input); err != nil {
+		return nil, err
+	}
+	if err := verifyNoFieldNumberCollision(input); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
+
+// extractIDFieldDescriptor returns the descriptor used for the id field of the request messages that address a
+// single entity (Get, Update, Delete). It mirrors the id field of the schema's message, so the request carries the
+// schema's real ID type instead of a fixed integer wrapper.
+func (a *Adapter) extractIDFieldDescriptor(genType *gen.Type) (*descriptorpb.FieldDescriptorProto, error) {
+	if !(genType.ID.Type.Type.Integer() || genType.ID.IsUUID() || genType.ID.IsString()) {
+		return nil, fmt.Errorf("entproto: schema %q id type %q is not supported by the generated service",
+			genType.Name, genType.ID.Type.String())
+	}
+	idField, err := a.converters[genType].ExtractIDFieldDescriptor(genType)
+	if err != nil {
+		return nil, fmt.Errorf("entproto: unable to extract id field descriptor for schema %q: %w", genType.Name, err)
+	}
+	return idField, nil
+}
+
+// repeatedIDField returns a repeated field holding IDs of the same type as idField.
+func repeatedIDField(idField *descriptorpb.FieldDescriptorProto, name string, number int32) *descriptorpb.FieldDescriptorProto {
+	repeatedFieldLabel := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     strptr(name),
+		Number:   int32ptr(number),
+		Label:    &repeatedFieldLabel,
+		Type:     idField.Type,
+		TypeName: idField.TypeName,
+	}
+}
+
+// extractEdgeEnum returns the <T>Edge enum listing the edges the Get and List methods can eager-load, or nil if the
+// schema has no edges.
+func extractEdgeEnum(genType *gen.Type) (*descriptorpb.EnumDescriptorProto, error) {
+	name := fmt.Sprintf("%sEdge", genType.Name)
+	prefix := strings.ToUpper(snake(name))
+	enum := &descriptorpb.EnumDescriptorProto{
+		Name: strptr(name),
+		Value: []*descriptorpb.EnumValueDescriptorProto{
+			{Name: strptr(prefix + "_UNSPECIFIED"), Number: int32ptr(0)},
+		},
+	}
+	for _, e := range genType.Edges {
+		if _, ok := e.Annotations[annotations.SkipAnnotation]; ok {
+			continue
+		}
+		edgeAnnotation, err := annotations.ExtractEdgeAnnotation(e)
+		if err != nil {
+			return nil, err
+		}
+		enum.Value = append(enum.Value, &descriptorpb.EnumValueDescriptorProto{
+			Name:   strptr(prefix + "_" + strings.ToUpper(snake(e.Name))),
+			Number: int32ptr(int32(edgeAnnotation.Number)),
+		})
+	}
+	if len(enum.Value) == 1 {
+		return nil, nil
+	}
+	return enum, nil
+}
+
+// extractWithField returns the with field selecting the edges eager-loaded by a Get or List request, or nil if the
+// schema has no edges.
+func extractWithField(genType *gen.Type, number int32) (*descriptorpb.FieldDescriptorProto, error) {
+	edgeEnum, err := extractEdgeEnum(genType)
+	if err != nil || edgeEnum == nil {
+		return nil, err
+	}
+	enumFieldType := descriptorpb.FieldDescriptorProto_TYPE_ENUM
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     strptr("with"),
+		Number:   int32ptr(number),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+		Type:     &enumFieldType,
+		TypeName: edgeEnum.Name,
+	}, nil
+}
+
+// extractEdgeUpdateFields returns the fields of Update<T>Request operating on an edge, as numbered by its
+// entproto.Field annotation: add_<edge>_ids and remove_<edge>_ids for non-unique edges, and clear_<edge> for unique
+// ones.
+func extractEdgeUpdateFields(converter *convert.Converter, input *descriptorpb.DescriptorProto, genType *gen.Type, e *gen.Edge) ([]*descriptorpb.FieldDescriptorProto, error) {
+	edgeAnnotation, err := annotations.ExtractEdgeAnnotation(e)
+	if err != nil {
+		return nil, err
+	}
+	var fields []*descriptorpb.FieldDescriptorProto
+	if e.Unique {
+		if edgeAnnotation.AddNumber != 0 || edgeAnnotation.RemoveNumber != 0 {
+			return nil, fmt.Errorf("entproto: unique edge %q of schema %q can't be numbered with entproto.EdgeUpdateNumbers",
+				e.Name, genType.Name)
+		}
+		if edgeAnnotation.ClearNumber == 0 {
+			return nil, nil
+		}
+		if !e.Optional {
+			return nil, fmt.Errorf("entproto: required edge %q of schema %q can't be cleared", e.Name, genType.Name)
+		}
+		return append(fields, &descriptorpb.FieldDescriptorProto{
+			Name:   strptr(fmt.Sprintf("clear_%s", snake(e.Name))),
+			Number: int32ptr(int32(edgeAnnotation.ClearNumber)),
+			Type:   descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum(),
+		}), nil
+	}
+	if edgeAnnotation.ClearNumber != 0 {
+		return nil, fmt.Errorf("entproto: non-unique edge %q of schema %q can't be numbered with entproto.EdgeClearNumber",
+			e.Name, genType.Name)
+	}
+	if edgeAnnotation.AddNumber == 0 && edgeAnnotation.RemoveNumber == 0 {
+		return nil, nil
+	}
+	if edgeAnnotation.AddNumber == 0 || edgeAnnotation.RemoveNumber == 0 {
+		return nil, fmt.Errorf("entproto: edge %q of schema %q must number both the add and remove fields", e.Name, genType.Name)
+	}
+	idType, err := converter.ExtractProtoTypeDetails(e.Type.ID, input)
+	if err != nil {
+		return nil, fmt.Errorf("entproto: unable to extract id type of schema %q edge %q: %w", genType.Name, e.Name, err)
+	}
+	for _, op := range []struct {
+		name   string
+		number int
+	}{
+		{"add", edgeAnnotation.AddNumber},
+		{"remove", edgeAnnotation.RemoveNumber},
+	} {
+		fields = append(fields, &descriptorpb.FieldDescriptorProto{
+			Name:     strptr(fmt.Sprintf("%s_%s_ids", op.name, snake(e.Name))),
+			Number:   int32ptr(int32(op.number)),
+			Type:     &idType.ProtoType,
+			TypeName: strptr(idType.MessageName),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+		})
+	}
+	return fields, nil
+}
+
+// fieldMaskField returns a google.protobuf.FieldMask field, such as the read_mask selecting the fields returned by a Get
+// or List request.
+func fieldMaskField(name string, number int32) *descriptorpb.FieldDescriptorProto {
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     strptr(name),
+		Number:   int32ptr(number),
+		Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+		TypeName: strptr("google.protobuf.FieldMask"),
+	}
+}
+
+// verifyWith checks the default edges of the service are edges of the schema listed in its <T>Edge enum.
+func (s *service) verifyWith(genType *gen.Type, edgeEnum *descriptorpb.EnumDescriptorProto) error {
+	if s.MaxWithDepth < 1 {
+		return fmt.Errorf("entproto: max with depth of schema %q must be at least 1", genType.Name)
+	}
+	for _, name := range s.DefaultWith {
+		found := false
+		if edgeEnum != nil {
+			value := strings.ToUpper(snake(edgeEnum.GetName())) + "_" + strings.ToUpper(snake(name))
+			for _, v := range edgeEnum.Value {
+				found = found || v.GetName() == value
+			}
+		}
+		if !found {
+			return fmt.Errorf("entproto: default with edge %q is not an edge of schema %q", name, genType.Name)
+		}
+	}
+	return nil
+}
+
+// Numbers of the fields of the List<T>Filter message combining nested filters. They are kept clear of the numbers
+// usually given to schema fields and filters.
+const (
+	filterAndFieldNumber = 1000
+	filterOrFieldNumber  = 1001
+	filterNotFieldNumber = 1002
+)
+
+// updateMaskFieldNumber is the number of the update_mask field of Update<T>Request. The other fields of the message
+// reuse the numbers of the schema's message, so it is kept clear of them.
+const updateMaskFieldNumber = 1000
+
+// verifyNoFieldNumberCollision makes sure no two fields of a generated request message share a field number.
+// The numbers of these messages are derived from annotations, so a collision means two annotations disagree.
+func verifyNoFieldNumberCollision(msg *descriptorpb.DescriptorProto) error {
+	seen := make(map[int32]string)
+	for _, fld := range msg.Field {
+		for _, r := range msg.ReservedRange {
+			if fld.GetNumber() >= r.GetStart() && fld.GetNumber() < r.GetEnd() {
+				return fmt.Errorf("entproto: field %q uses the number %d which is reserved on message %q",
+					fld.GetName(), fld.GetNumber(), msg.GetName())
+			}
+		}
+		if slices.Contains(msg.ReservedName, fld.GetName()) {
+			return fmt.Errorf("entproto: field name %q is reserved on message %q", fld.GetName(), msg.GetName())
+		}
+		if other, ok := seen[fld.GetNumber()]; ok {
+			return fmt.Errorf("entproto: fields %q and %q of message %q share the field number %d",
+				other, fld.GetName(), msg.GetName(), fld.GetNumber())
+		}
+		seen[fld.GetNumber()] = fld.GetName()
+	}
+	return nil
+}
+
+// reserveMessageFields reserves the field numbers and names reserved on the message of the schema in a message
+// mirroring its fields, such as Update<T>Request and List<T>Filter.
+func reserveMessageFields(genType *gen.Type, msg *descriptorpb.DescriptorProto) error {
+	msgAnnot, err := annotations.ExtractMessageAnnotation(genType)
+	if err != nil {
+		return err
+	}
+	ranges, err := convert.ReservedRanges(genType.Name, msgAnnot.Reserved)
+	if err != nil {
+		return err
+	}
+	msg.ReservedRange = append(msg.ReservedRange, ranges...)
+	msg.ReservedName = append(msg.ReservedName, msgAnnot.ReservedNames...)
+	return nil
+}
+
 type methodResources struct {
 	methodDescriptor *descriptorpb.MethodDescriptorProto
 	messages         []*descriptorpb.DescriptorProto
+	enums            []*descriptorpb.EnumDescriptorProto
 }
 
 type serviceResources struct {
 	svc         *descriptorpb.ServiceDescriptorProto
 	svcMessages []*descriptorpb.DescriptorProto
+	svcEnums    []*descriptorpb.EnumDescriptorProto
 }
 
-func extractServiceAnnotation(sch *gen.Type) (*service, error) {
+// ExtractServiceAnnotation returns the entproto.Service annotation of the schema.
+func ExtractServiceAnnotation(sch *gen.Type) (*service, error) {
 	annot, ok := sch.Annotations[ServiceAnnotation]
 	if !ok {
 		return nil, fmt.Errorf("%w: entproto: schema %q does not have an entproto.Service annotation",
@@ -397,6 +1267,19 @@ func extractServiceAnnotation(sch *gen.Type) (*service, error) {
 	return &out, nil
 }
 
+func dedupeServiceEnums(enums []*descriptorpb.EnumDescriptorProto) []*descriptorpb.EnumDescriptorProto {
+	out := make([]*descriptorpb.EnumDescriptorProto, 0, len(enums))
+	seen := make(map[string]struct{})
+	for _, enum := range enums {
+		if _, skip := seen[enum.GetName()]; skip {
+			continue
+		}
+		out = append(out, enum)
+		seen[enum.GetName()] = struct{}{}
+	}
+	return out
+}
+
 func dedupeServiceMessages(msgs []*descriptorpb.DescriptorProto) []*descriptorpb.DescriptorProto {
 	out := make([]*descriptorpb.DescriptorProto, 0, len(msgs))
 	seen := make(map[string]struct{})
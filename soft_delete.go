@@ -0,0 +1,99 @@
+package entproto
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/go-viper/mapstructure/v2"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	SoftDeleteAnnotation = "ProtoSoftDelete"
+
+	// The numbers of the show_deleted field of the Get, List, Count and Aggregate requests of soft-deleted schemas.
+	getShowDeletedFieldNumber       = 4
+	listShowDeletedFieldNumber      = 11
+	countShowDeletedFieldNumber     = 2
+	aggregateShowDeletedFieldNumber = 4
+)
+
+// SoftDelete makes the generated service soft-delete the entities of the schema: Delete and BatchDelete set the given
+// optional time field to the current time instead of deleting the rows, Get and List skip the rows where it is set
+// unless the request sets show_deleted, which runtime.BaseService.SetShowDeletedPolicy must allow, and an Undelete
+// method clears it.
+func SoftDelete(fieldName string) *softDelete {
+	return &softDelete{Field: fieldName}
+}
+
+type softDelete struct {
+	Field string `json:"field" mapstructure:"field"`
+}
+
+func (*softDelete) Name() string {
+	return SoftDeleteAnnotation
+}
+
+// ExtractSoftDeleteField returns the field set when the entities of the schema are soft-deleted, or nil if the schema
+// isn't annotated with entproto.SoftDelete.
+func ExtractSoftDeleteField(sch *gen.Type) (*gen.Field, error) {
+	annot, ok := sch.Annotations[SoftDeleteAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	var out softDelete
+	if err := mapstructure.Decode(annot, &out); err != nil {
+		return nil, fmt.Errorf("entproto: unable to decode entproto.SoftDelete annotation for schema %q: %w",
+			sch.Name, err)
+	}
+	for _, f := range sch.Fields {
+		if f.Name != out.Field {
+			continue
+		}
+		if f.Type.Type != field.TypeTime || f.Type.RType != nil {
+			return nil, fmt.Errorf("entproto: soft delete field %q of schema %q must be a time.Time field",
+				f.Name, sch.Name)
+		}
+		if !f.Optional || f.Immutable {
+			return nil, fmt.Errorf("entproto: soft delete field %q of schema %q must be optional and mutable",
+				f.Name, sch.Name)
+		}
+		return f, nil
+	}
+	return nil, fmt.Errorf("entproto: soft delete field %q not found in schema %q", out.Field, sch.Name)
+}
+
+// showDeletedField returns the show_deleted field of the read requests of soft-deleted schemas.
+func showDeletedField(number int32) *descriptorpb.FieldDescriptorProto {
+	return &descriptorpb.FieldDescriptorProto{
+		Name:   strptr("show_deleted"),
+		Number: int32ptr(number),
+		Type:   descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum(),
+	}
+}
+
+// genUndeleteProtos returns the Undelete method restoring soft-deleted entities, generated along with the Delete and
+// BatchDelete methods of soft-deleted schemas.
+func (a *Adapter) genUndeleteProtos(genType *gen.Type) (methodResources, error) {
+	softDeleteField, err := ExtractSoftDeleteField(genType)
+	if err != nil || softDeleteField == nil {
+		return methodResources{}, err
+	}
+	idField, err := a.extractIDFieldDescriptor(genType)
+	if err != nil {
+		return methodResources{}, err
+	}
+	input := &descriptorpb.DescriptorProto{
+		Name:  strptr(fmt.Sprintf("Undelete%sRequest", genType.Name)),
+		Field: []*descriptorpb.FieldDescriptorProto{idField},
+	}
+	return methodResources{
+		methodDescriptor: &descriptorpb.MethodDescriptorProto{
+			Name:       strptr("Undelete"),
+			InputType:  input.Name,
+			OutputType: strptr(genType.Name),
+		},
+		messages: []*descriptorpb.DescriptorProto{input},
+	}, nil
+}
@@ -0,0 +1,115 @@
+package entproto
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/yoshino-s/entproto/annotations"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// conflictIndexNumberBase is the number of the first <T>ConflictTarget value of a unique index. The values of unique
+// fields are numbered after their entproto.Field annotation, the ones of indexes follow their order in the schema.
+const conflictIndexNumberBase = 1000
+
+// ConflictTarget is a unique field or index of a schema the Upsert method can resolve conflicts on.
+type ConflictTarget struct {
+	// Value is the name of the <T>ConflictTarget enum value selecting the target.
+	Value  string
+	Number int32
+	// Field is set if the target is a unique field.
+	Field *gen.Field
+	// Columns are the table columns of the target.
+	Columns []string
+}
+
+// ExtractConflictTargets returns the unique fields and the unique indexes of a schema, in the order of the values
+// of its <T>ConflictTarget enum.
+func ExtractConflictTargets(genType *gen.Type) ([]*ConflictTarget, error) {
+	prefix := strings.ToUpper(snake(genType.Name + "ConflictTarget"))
+	var (
+		targets []*ConflictTarget
+		columns = make(map[string]bool)
+	)
+	for _, f := range genType.Fields {
+		if !f.Unique {
+			continue
+		}
+		if _, ok := f.Annotations[annotations.SkipAnnotation]; ok {
+			continue
+		}
+		fieldAnnotation, err := annotations.ExtractFieldAnnotation(f)
+		if err != nil {
+			return nil, err
+		}
+		if fieldAnnotation.Number >= conflictIndexNumberBase {
+			return nil, fmt.Errorf("entproto: unique field %q of schema %q is numbered %d, upsert conflict targets "+
+				"require unique fields numbered below %d", f.Name, genType.Name, fieldAnnotation.Number, conflictIndexNumberBase)
+		}
+		targets = append(targets, &ConflictTarget{
+			Value:   prefix + "_" + strings.ToUpper(snake(f.Name)),
+			Number:  int32(fieldAnnotation.Number),
+			Field:   f,
+			Columns: []string{f.StorageKey()},
+		})
+		columns[f.StorageKey()] = true
+	}
+	var n int32
+	for _, idx := range genType.Indexes {
+		if !idx.Unique {
+			continue
+		}
+		// Single column indexes of unique fields are already listed.
+		if len(idx.Columns) == 1 && columns[idx.Columns[0]] {
+			continue
+		}
+		targets = append(targets, &ConflictTarget{
+			Value:   prefix + "_" + strings.ToUpper(strings.Join(idx.Columns, "_")),
+			Number:  conflictIndexNumberBase + n,
+			Columns: idx.Columns,
+		})
+		n++
+	}
+	return targets, nil
+}
+
+// extractConflictTargetEnum returns the <T>ConflictTarget enum of the Upsert method.
+func extractConflictTargetEnum(genType *gen.Type) (*descriptorpb.EnumDescriptorProto, error) {
+	targets, err := ExtractConflictTargets(genType)
+	if err != nil {
+		return nil, err
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("entproto: schema %q has no unique field or index for the upsert method to resolve "+
+			"conflicts on", genType.Name)
+	}
+	name := genType.Name + "ConflictTarget"
+	enum := &descriptorpb.EnumDescriptorProto{
+		Name: strptr(name),
+		Value: []*descriptorpb.EnumValueDescriptorProto{
+			{Name: strptr(strings.ToUpper(snake(name)) + "_UNSPECIFIED"), Number: int32ptr(0)},
+		},
+	}
+	for _, t := range targets {
+		enum.Value = append(enum.Value, &descriptorpb.EnumValueDescriptorProto{
+			Name:   strptr(t.Value),
+			Number: int32ptr(t.Number),
+		})
+	}
+	return enum, nil
+}
+
+// verifyUpsertFeature returns an error if the ent client was generated without the upsert feature, which the Upsert
+// method is built on.
+func (a *Adapter) verifyUpsertFeature(genType *gen.Type) error {
+	enabled, err := a.graph.FeatureEnabled(gen.FeatureUpsert.Name)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return fmt.Errorf("entproto: schema %q uses entproto.MethodUpsert, but the ent client is generated without "+
+			"the %q feature. Enable gen.FeatureUpsert in the ent generator config", genType.Name, gen.FeatureUpsert.Name)
+	}
+	return nil
+}
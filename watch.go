@@ -0,0 +1,94 @@
+package entproto
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// WatchEvents are the changes reported by the Watch method, in the order of the values of its <T>WatchEventType
+// enum. They match the runtime.EventType values published by the generated mutation handlers.
+var WatchEvents = []string{"created", "updated", "deleted"}
+
+// genWatchProtos returns the Watch method streaming the changes of the entities matching a List<T>Filter.
+func (a *Adapter) genWatchProtos(genType *gen.Type) (methodResources, error) {
+	idField, err := a.extractIDFieldDescriptor(genType)
+	if err != nil {
+		return methodResources{}, err
+	}
+	protoMessageFieldType := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	enumFieldType := descriptorpb.FieldDescriptorProto_TYPE_ENUM
+	noSideEffectIdempotencyLevel := descriptorpb.MethodOptions_NO_SIDE_EFFECTS
+	serverStreaming := true
+
+	eventEnum := extractWatchEventTypeEnum(genType)
+	input := &descriptorpb.DescriptorProto{
+		Name: strptr(fmt.Sprintf("Watch%sRequest", genType.Name)),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     strptr("filter"),
+				Number:   int32ptr(1),
+				Type:     &protoMessageFieldType,
+				TypeName: strptr(fmt.Sprintf("List%sFilter", genType.Name)),
+			},
+		},
+	}
+	output := &descriptorpb.DescriptorProto{
+		Name: strptr(fmt.Sprintf("Watch%sResponse", genType.Name)),
+		Field: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     strptr("type"),
+				Number:   int32ptr(1),
+				Type:     &enumFieldType,
+				TypeName: eventEnum.Name,
+			},
+			{
+				Name:     idField.Name,
+				Number:   int32ptr(2),
+				Type:     idField.Type,
+				TypeName: idField.TypeName,
+			},
+			// The entity isn't set by deleted events.
+			{
+				Name:     strptr(snake(genType.Name)),
+				Number:   int32ptr(3),
+				Type:     &protoMessageFieldType,
+				TypeName: strptr(genType.Name),
+			},
+		},
+	}
+	return methodResources{
+		methodDescriptor: &descriptorpb.MethodDescriptorProto{
+			Name:            strptr("Watch"),
+			InputType:       input.Name,
+			OutputType:      output.Name,
+			ServerStreaming: &serverStreaming,
+			Options: &descriptorpb.MethodOptions{
+				IdempotencyLevel: &noSideEffectIdempotencyLevel,
+			},
+		},
+		messages: []*descriptorpb.DescriptorProto{input, output},
+		enums:    []*descriptorpb.EnumDescriptorProto{eventEnum},
+	}, nil
+}
+
+// extractWatchEventTypeEnum returns the <T>WatchEventType enum of the Watch method.
+func extractWatchEventTypeEnum(genType *gen.Type) *descriptorpb.EnumDescriptorProto {
+	name := genType.Name + "WatchEventType"
+	prefix := strings.ToUpper(snake(name))
+	enum := &descriptorpb.EnumDescriptorProto{
+		Name: strptr(name),
+		Value: []*descriptorpb.EnumValueDescriptorProto{
+			{Name: strptr(prefix + "_UNSPECIFIED"), Number: int32ptr(0)},
+		},
+	}
+	for i, event := range WatchEvents {
+		enum.Value = append(enum.Value, &descriptorpb.EnumValueDescriptorProto{
+			Name:   strptr(prefix + "_" + strings.ToUpper(event)),
+			Number: int32ptr(int32(i + 1)),
+		})
+	}
+	return enum
+}